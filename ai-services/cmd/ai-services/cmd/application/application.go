@@ -27,8 +27,11 @@ func init() {
 	ApplicationCmd.AddCommand(startCmd)
 	ApplicationCmd.AddCommand(infoCmd)
 	ApplicationCmd.AddCommand(logsCmd)
+	ApplicationCmd.AddCommand(execCmd)
+	ApplicationCmd.AddCommand(topCmd)
+	ApplicationCmd.AddCommand(doctorCmd)
 	ApplicationCmd.AddCommand(model.ModelCmd)
-	ApplicationCmd.PersistentFlags().StringVar(&vars.ToolImage, "tool-image", vars.ToolImage, "Tool image to use for downloading the model(only for the development purpose)")
+	ApplicationCmd.PersistentFlags().StringVar(&vars.ToolImage, "tool-image", vars.ToolImage, "Tool image to use for the housekeeping tasks(only for the development purpose)")
 	ApplicationCmd.PersistentFlags().BoolVar(&hiddenTemplates, "hidden", false, "Show hidden templates")
 	_ = ApplicationCmd.PersistentFlags().MarkHidden("tool-image")
 	_ = ApplicationCmd.PersistentFlags().MarkHidden("hidden")
@@ -3,28 +3,39 @@ package application
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
-	"os/exec"
+	"os"
+	"os/signal"
+	"path/filepath"
 	"slices"
 	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"text/template"
 	"time"
 
+	"github.com/containers/podman/v5/pkg/domain/entities/types"
 	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
 
 	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/bootstrap"
+	"github.com/project-ai-services/ai-services/internal/pkg/bundle"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/hf"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
 	"github.com/project-ai-services/ai-services/internal/pkg/image"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/modelstore"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/smt"
 	"github.com/project-ai-services/ai-services/internal/pkg/specs"
 	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
@@ -32,6 +43,11 @@ import (
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
+const (
+	appDirPermissions  = 0o755
+	appFilePermissions = 0o600
+)
+
 var (
 	extraContainerReadinessTimeout = 5 * time.Minute
 	containerCreationTimeout       = 10 * time.Minute
@@ -44,10 +60,15 @@ var (
 	skipModelDownload     bool
 	skipImageDownload     bool
 	skipChecks            []string
+	rawValidationProfile  string
+	validationProfile     *validators.Profile
+	bundlePath            string
+	appBundle             *bundle.Bundle
 	rawArgParams          []string
 	argParams             map[string]string
 	valuesFiles           []string
 	values                map[string]any
+	servedModels          []templates.Model
 	rawArgImagePullPolicy string
 	imagePullPolicy       image.ImagePullPolicy
 )
@@ -88,6 +109,12 @@ var createCmd = &cobra.Command{
 			return fmt.Errorf("failed to load params for application: %w", err)
 		}
 
+		// validate the models the application serves, Eg:- models.instruct.id
+		servedModels, err = templates.ParseModels(values)
+		if err != nil {
+			return err
+		}
+
 		// validate ImagePullPolicy
 		imagePullPolicy = image.ImagePullPolicy(rawArgImagePullPolicy)
 		if ok := imagePullPolicy.Valid(); !ok {
@@ -97,13 +124,31 @@ var createCmd = &cobra.Command{
 			)
 		}
 
+		validationProfile, err = validators.LoadProfile(rawValidationProfile)
+		if err != nil {
+			return err
+		}
+
+		if bundlePath != "" {
+			appBundle, err = bundle.Open(bundlePath)
+			if err != nil {
+				return err
+			}
+			if appBundle.Manifest.Template != templateName {
+				return fmt.Errorf("bundle %s is created for template '%s', not '%s'", bundlePath, appBundle.Manifest.Template, templateName)
+			}
+		}
+
 		appName := args[0]
 
 		return utils.VerifyAppName(appName)
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		appName := args[0]
-		ctx := context.Background()
+
+		// Cancel cleanly on Ctrl+C / SIGTERM and report the state of pods left behind
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
 
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
@@ -113,12 +158,19 @@ var createCmd = &cobra.Command{
 			logger.Warningf("Skipping validation checks (skipped: %v)\n", skipChecks)
 		}
 
+		// the LPAR is not expected to reach RHN while deploying from a bundle
+		if appBundle != nil {
+			validationProfile = validationProfile.WithWarning("rhn")
+		}
+
 		// Validate the LPAR before creating the application
-		logger.Infof("Validating the LPAR environment before creating application '%s'...\n", appName)
-		err := bootstrap.RunValidateCmd(skip)
+		logger.Infof("Validating the LPAR environment before creating application '%s' (profile: %s)...\n", appName, validationProfile.Name)
+		report, err := bootstrap.RunValidateCmd(ctx, skip, validationProfile)
 		if err != nil {
 			return fmt.Errorf("bootstrap validation failed: %w", err)
 		}
+		// stored only once the validation passes, as the application directory marks the application as existing
+		storeValidationReport(report, appName)
 
 		// podman connectivity
 		runtime, err := podman.NewPodmanClient()
@@ -126,13 +178,20 @@ var createCmd = &cobra.Command{
 			return fmt.Errorf("failed to connect to podman: %w", err)
 		}
 
+		defer func() {
+			if ctx.Err() != nil {
+				helpers.ReportInterruptedPods(runtime, appName, ctx.Err())
+				logger.Infof("Re-run 'ai-services application create %s' to resume or 'ai-services application delete %s' to clean up\n", appName, appName)
+			}
+		}()
+
 		// Proceed to create application
 		logger.Infof("Creating application '%s' using template '%s'\n", appName, templateName)
 
 		// set SMT level to target value, assuming it is running with root privileges (part of validation in bootstrap)
 		s := spinner.New("Checking SMT level")
 		s.Start(ctx)
-		err = setSMTLevel()
+		err = setSMTLevel(ctx, appName)
 		if err != nil {
 			s.Fail("failed to set SMT level")
 
@@ -169,7 +228,7 @@ var createCmd = &cobra.Command{
 			3. Else, skip existing pods, and create missing pods
 		*/
 
-		existingPods, err := helpers.CheckExistingPodsForApplication(runtime, appName)
+		existingPods, err := helpers.CheckExistingPodsForApplication(ctx, runtime, appName)
 		if err != nil {
 			return fmt.Errorf("failed while checking existing pods for application: %w", err)
 		}
@@ -181,10 +240,20 @@ var createCmd = &cobra.Command{
 			return nil
 		}
 
+		// render the pod specs of only those pods which are not deployed yet
+		pendingPodSpecs, err := fetchPendingPodSpecs(ctx, runtime, tp, utils.ExtractMapKeys(tmpls), templateName, appName)
+		if err != nil {
+			return err
+		}
+
+		// ---- Validate Host Requirements ----
+		if err := validateHostRequirements(ctx, report, appMetadata, pendingPodSpecs, appName, skip); err != nil {
+			return err
+		}
+
 		// ---- Validate Spyre card Requirements ----
 
-		// calculate the required spyre cards of only those pods which are not deployed yet
-		reqSpyreCardsCount, err := calculateReqSpyreCards(runtime, tp, utils.ExtractMapKeys(tmpls), templateName, appName)
+		reqSpyreCardsCount, err := calculateReqSpyreCards(pendingPodSpecs)
 		if err != nil {
 			return fmt.Errorf("failed to calculateReqSpyreCards: %w", err)
 		}
@@ -204,8 +273,28 @@ var createCmd = &cobra.Command{
 			}
 		}
 
+		// ---- Validate the models fit the Spyre cards requested ----
+		// the models are looked up on the hub, hence only the models to be downloaded from it are validated
+		if appBundle == nil && !skipModelDownload {
+			if err := validateModelsFit(ctx, servedModels); err != nil {
+				return err
+			}
+		}
+
+		modelRefs, err := helpers.ListModelsWithValues(templateName, appName, values)
+		if err != nil {
+			return fmt.Errorf("failed to list models: %w", err)
+		}
+
+		// ---- Install Container Images and Models from the bundle ----
+		if appBundle != nil {
+			if err := installFromBundle(ctx, appBundle, modelRefs); err != nil {
+				return err
+			}
+		}
+
 		// ---- Download Container Images ----
-		if err := downloadImagesForTemplate(runtime, templateName, appName); err != nil {
+		if err := downloadImagesForTemplate(ctx, runtime, templateName, appName); err != nil {
 			return err
 		}
 
@@ -213,20 +302,14 @@ var createCmd = &cobra.Command{
 		if !skipModelDownload {
 			s = spinner.New("Downloading models as part of application creation...")
 			s.Start(ctx)
-			models, err := helpers.ListModels(templateName, appName)
-			if err != nil {
-				s.Fail("failed to list models")
-
-				return err
-			}
 			logger.Infoln("Downloading models required for application template " + templateName + ":")
-			for _, model := range models {
-				s.UpdateMessage("Downloading model: " + model + "...")
-				err = utils.Retry(vars.RetryCount, vars.RetryInterval, nil, func() error {
-					return helpers.DownloadModel(model, vars.ModelDirectory)
+			for _, model := range modelRefs {
+				s.UpdateMessage("Downloading model: " + model.String() + "...")
+				err = utils.Retry(ctx, vars.RetryCount, vars.RetryInterval, nil, func() error {
+					return helpers.DownloadModel(ctx, model, vars.ModelDirectory, helpers.ModelDownloadProgress(model.String(), s.UpdateMessage))
 				})
 				if err != nil {
-					s.Fail("failed to download model: " + model)
+					s.Fail("failed to download model: " + model.String())
 
 					return fmt.Errorf("failed to download model: %w", err)
 				}
@@ -234,6 +317,13 @@ var createCmd = &cobra.Command{
 			s.Stop("Model download completed.")
 		}
 
+		helpers.MarkModelsUsed(modelRefs, vars.ModelDirectory)
+		// the pods already deployed keep serving the models they were started with, hence the commits are recorded
+		// only once none of the pods are deployed yet
+		if len(existingPods) == 0 || !utils.FileExists(filepath.Join(constants.ApplicationsPath, appName, constants.ModelRevisionsFile)) {
+			storeModelRevisions(appName, modelRefs)
+		}
+
 		// ---- ! ----
 
 		// Loop through all pod templates, render and run kube play
@@ -242,7 +332,7 @@ var createCmd = &cobra.Command{
 		s = spinner.New("Deploying application '" + appName + "'...")
 		s.Start(ctx)
 		// execute the pod Templates
-		if err := executePodTemplates(runtime, tp, appName, appMetadata, tmpls, pciAddresses, existingPods); err != nil {
+		if err := executePodTemplates(ctx, runtime, tp, appName, appMetadata, tmpls, pciAddresses, existingPods); err != nil {
 			return err
 		}
 		s.Stop("Application '" + appName + "' deployed successfully")
@@ -250,7 +340,7 @@ var createCmd = &cobra.Command{
 		logger.Infoln("-------")
 
 		// print the next steps to be performed at the end of create
-		if err := helpers.PrintNextSteps(runtime, appName, templateName); err != nil {
+		if err := helpers.PrintNextSteps(ctx, runtime, appName, templateName); err != nil {
 			// do not want to fail the overall create if we cannot print next steps
 			logger.Infof("failed to display next steps: %v\n", err)
 
@@ -261,7 +351,46 @@ var createCmd = &cobra.Command{
 	},
 }
 
-func downloadImagesForTemplate(runtime runtime.Runtime, templateName, appName string) error {
+// installFromBundle loads the container images and installs the models from the bundle. The images are then only
+// verified to be present locally and the models are not downloaded, hence the models of the application must be
+// installed from the bundle.
+func installFromBundle(ctx context.Context, b *bundle.Bundle, modelRefs []hf.Ref) error {
+	s := spinner.New("Loading container images from the bundle " + b.Path())
+	s.Start(ctx)
+	if err := b.LoadImages(ctx, host.Local().Runner); err != nil {
+		s.Fail("failed to load container images from the bundle")
+
+		return err
+	}
+	s.Stop("Container images loaded from the bundle")
+	imagePullPolicy = image.PullNever
+
+	s = spinner.New("Installing models from the bundle " + b.Path())
+	s.Start(ctx)
+	if err := b.InstallModels(vars.ModelDirectory); err != nil {
+		s.Fail("failed to install models from the bundle")
+
+		return err
+	}
+	s.Stop("Models installed from the bundle")
+	skipModelDownload = true
+
+	// the bundle holds the models of the default values of the template, which the values set may override
+	var missing []string
+	for _, ref := range modelRefs {
+		if !modelstore.IsDownloaded(filepath.Join(vars.ModelDirectory, ref.Repo), ref) {
+			missing = append(missing, ref.String())
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("the models %s are not in the bundle %s, please copy them into the model directory %s",
+			strings.Join(missing, ", "), b.Path(), vars.ModelDirectory)
+	}
+
+	return nil
+}
+
+func downloadImagesForTemplate(ctx context.Context, runtime runtime.Runtime, templateName, appName string) error {
 	/// Deprecated: if skipImageDownload is passed, then consider it
 	if skipImageDownload {
 		// if skipImageDownload flag is set, then override the image pull policy to Never
@@ -272,12 +401,16 @@ func downloadImagesForTemplate(runtime runtime.Runtime, templateName, appName st
 	imagePull := image.NewImagePull(runtime, imagePullPolicy, appName, templateName)
 
 	// based on the imagePullPolicy set, download the images
-	return imagePull.Run()
+	return imagePull.Run(ctx)
 }
 
 func init() {
-	skipCheckDesc := bootstrap.BuildSkipFlagDescription()
+	skipCheckDesc := bootstrap.BuildSkipFlagDescription(validators.RequirementRuleNames...)
 	createCmd.Flags().StringSliceVar(&skipChecks, "skip-validation", []string{}, skipCheckDesc)
+	bootstrap.AddProfileFlag(createCmd, &rawValidationProfile)
+	createCmd.Flags().StringVar(&bundlePath, "bundle", "",
+		"Load the container images and install the models from the bundle created by 'ai-services bundle create'\n\n"+
+			"Recommended for air-gapped environments, the images are verified to be present locally and the models are not downloaded\n")
 	createCmd.Flags().StringVarP(&templateName, "template", "t", "", "Application template to use (required)")
 	_ = createCmd.MarkFlagRequired("template")
 	// Add a flag for skipping image download
@@ -357,44 +490,13 @@ func deprecatedFlags() {
 	}
 }
 
-func getSMTLevel(output string) (int, error) {
-	out := strings.TrimSpace(output)
-
-	if !strings.HasPrefix(out, "SMT=") {
-		return 0, fmt.Errorf("unexpected output: %s", out)
-	}
-
-	SMTLevelStr := strings.TrimPrefix(out, "SMT=")
-	SMTlevel, err := strconv.Atoi(SMTLevelStr)
-	if err != nil {
-		return 0, fmt.Errorf("failed to parse SMT level: %w", err)
-	}
-
-	return SMTlevel, nil
-}
-
-func setSMTLevel() error {
+func setSMTLevel(ctx context.Context, appName string) error {
 	/*
-		1. Fetch current SMT level
-		2. Fetch the target SMT level
-		3. Check if SMT level is already set to target value
-		4. If not, set it to target value
-		5. Verify again
+		1. Fetch the target SMT level
+		2. Set it through the SMT manager, which records the original level and the application requiring the level
 	*/
 
-	// 1. Fetch Current SMT level
-	cmd := exec.Command("ppc64_cpu", "--smt")
-	out, err := cmd.CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("failed to check current SMT level: %v, output: %s", err, string(out))
-	}
-
-	currentSMTlevel, err := getSMTLevel(string(out))
-	if err != nil {
-		return fmt.Errorf("failed to get current SMT level: %w", err)
-	}
-
-	// 2. Fetch the target SMT level
+	// 1. Fetch the target SMT level
 	targetSMTLevel, err := getTargetSMTLevel()
 	if err != nil {
 		return fmt.Errorf("failed to get target SMT level: %w", err)
@@ -402,42 +504,16 @@ func setSMTLevel() error {
 
 	if targetSMTLevel == nil {
 		// No SMT level specified in metadata.yaml
-		logger.Infof("No SMT level specified in metadata.yaml. Keeping it to current level: %d\n", currentSMTlevel)
+		logger.Infoln("No SMT level specified in metadata.yaml. Keeping it to current level")
 
 		return nil
 	}
 
-	// 3. Check if SMT level is already set to target value
-	if currentSMTlevel == *targetSMTLevel {
-		// already set
-		logger.Infof("SMT level is already set to %d\n", *targetSMTLevel)
-
-		return nil
-	}
-
-	// 4. Set SMT level to target value
-	arg := "--smt=" + strconv.Itoa(*targetSMTLevel)
-	cmd = exec.Command("ppc64_cpu", arg)
-	out, err = cmd.CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("failed to set SMT level: %v, output: %s", err, string(out))
-	}
-
-	// 5. Verify again
-	cmd = exec.Command("ppc64_cpu", "--smt")
-	out, err = cmd.CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("failed to verify SMT level: %v, output: %s", err, string(out))
-	}
-
-	currentSMTlevel, err = getSMTLevel(string(out))
-	if err != nil {
-		return fmt.Errorf("failed to get SMT level after updating: %w", err)
-	}
-
-	if currentSMTlevel != *targetSMTLevel {
-		return fmt.Errorf("SMT level verification failed: expected %d, got %d", targetSMTLevel, currentSMTlevel)
+	// 2. Set SMT level to target value, the original level is restored once the last application requiring it is deleted
+	if err := smt.NewManager(host.Local()).Acquire(ctx, appName, *targetSMTLevel); err != nil {
+		return err
 	}
+	logger.Infof("SMT level is set to %d\n", *targetSMTLevel)
 
 	return nil
 }
@@ -476,7 +552,7 @@ func verifyPodTemplateExists(tmpls map[string]*template.Template, appMetadata *t
 	return nil
 }
 
-func executePodTemplateLayer(runtime runtime.Runtime, tp templates.Template, tmpls map[string]*template.Template,
+func executePodTemplateLayer(ctx context.Context, runtime runtime.Runtime, tp templates.Template, tmpls map[string]*template.Template,
 	globalParams map[string]any, pciAddresses []string, existingPods []string, podTemplateName, appName string) error {
 	logger.Infof("'%s': Processing template...\n", podTemplateName)
 
@@ -512,18 +588,20 @@ func executePodTemplateLayer(runtime runtime.Runtime, tp templates.Template, tmp
 		return fmt.Errorf("'%s': Failed to parse pod template: %w", podTemplateName, err)
 	}
 
+	storeAppFile(appName, filepath.Join(constants.RenderedTemplatesDir, strings.TrimSuffix(podTemplateName, ".tmpl")), rendered.Bytes())
+
 	// Wrap the bytes in a bytes.Reader
 	reader := bytes.NewReader(rendered.Bytes())
 
 	// Deploy the Pod and do Readiness check
-	if err := deployPodAndReadinessCheck(runtime, podSpec, podTemplateName, reader, constructPodDeployOptions(podAnnotations)); err != nil {
+	if err := deployPodAndReadinessCheck(ctx, runtime, podSpec, podTemplateName, reader, constructPodDeployOptions(podAnnotations)); err != nil {
 		return fmt.Errorf("'%s': Failed to deploy pod and do readiness check: %w", podTemplateName, err)
 	}
 
 	return nil
 }
 
-func executePodTemplates(runtime runtime.Runtime, tp templates.Template,
+func executePodTemplates(ctx context.Context, runtime runtime.Runtime, tp templates.Template,
 	appName string, appMetadata *templates.AppMetadata,
 	tmpls map[string]*template.Template, pciAddresses []string, existingPods []string) error {
 	globalParams := map[string]any{
@@ -536,6 +614,8 @@ func executePodTemplates(runtime runtime.Runtime, tp templates.Template,
 		"env": map[string]map[string]string{},
 	}
 
+	storeValues(appName)
+
 	// looping over each layer of podTemplateExecutions
 	for i, layer := range appMetadata.PodTemplateExecutions {
 		logger.Infof("\n Executing Layer %d/%d: %v\n", i+1, len(appMetadata.PodTemplateExecutions), layer)
@@ -548,7 +628,7 @@ func executePodTemplates(runtime runtime.Runtime, tp templates.Template,
 			wg.Add(1)
 			go func(t string) {
 				defer wg.Done()
-				if err := executePodTemplateLayer(runtime, tp, tmpls, globalParams, pciAddresses, existingPods, podTemplateName, appName); err != nil {
+				if err := executePodTemplateLayer(ctx, runtime, tp, tmpls, globalParams, pciAddresses, existingPods, podTemplateName, appName); err != nil {
 					errCh <- err
 				}
 			}(podTemplateName)
@@ -574,14 +654,14 @@ func executePodTemplates(runtime runtime.Runtime, tp templates.Template,
 	return nil
 }
 
-func doContainersCreationCheck(runtime runtime.Runtime, podSpec *models.PodSpec, podTemplateName, podName, podID string) error {
+func doContainersCreationCheck(ctx context.Context, runtime runtime.Runtime, podSpec *models.PodSpec, podTemplateName, podName, podID string) error {
 	logger.Infof("'%s', '%s': Performing Containers Creation check for pod...\n", podTemplateName, podName)
 
 	expectedContainerCount := len(specs.FetchContainerNames(*podSpec))
 
 	logger.Infof("'%s', '%s': Waiting for Containers Creation... Timeout set: %s\n", podTemplateName, podName, containerCreationTimeout)
 	// wait for all containers for a given pod are created
-	if err := helpers.WaitForContainersCreation(runtime, podID, expectedContainerCount, containerCreationTimeout); err != nil {
+	if err := helpers.WaitForContainersCreation(ctx, runtime, podID, expectedContainerCount, containerCreationTimeout); err != nil {
 		return fmt.Errorf("containers creation check failed for pod: '%s' with error: %w", podName, err)
 	}
 
@@ -590,8 +670,8 @@ func doContainersCreationCheck(runtime runtime.Runtime, podSpec *models.PodSpec,
 	return nil
 }
 
-func doContainerReadinessCheck(runtime runtime.Runtime, podTemplateName, podName, containerID string) error {
-	cInfo, err := runtime.InspectContainer(containerID)
+func doContainerReadinessCheck(ctx context.Context, runtime runtime.Runtime, podTemplateName, podName, containerID string) error {
+	cInfo, err := runtime.InspectContainer(ctx, containerID)
 	if err != nil {
 		return fmt.Errorf("failed to do container inspect for containerID: '%s' with error: %w", containerID, err)
 	}
@@ -599,7 +679,7 @@ func doContainerReadinessCheck(runtime runtime.Runtime, podTemplateName, podName
 	logger.Infof("'%s', '%s', '%s': Performing Container Readiness check...\n", podTemplateName, podName, cInfo.Name)
 
 	// getting the Start Period set for a container
-	startPeriod, err := helpers.FetchContainerStartPeriod(runtime, containerID)
+	startPeriod, err := helpers.FetchContainerStartPeriod(ctx, runtime, containerID)
 	if err != nil {
 		return fmt.Errorf("fetching container: '%s' start period failed: %w", cInfo.Name, err)
 	}
@@ -615,7 +695,7 @@ func doContainerReadinessCheck(runtime runtime.Runtime, podTemplateName, podName
 
 	logger.Infof("'%s', '%s', '%s': Waiting for Container Readiness... Timeout set: %s\n", podTemplateName, podName, cInfo.Name, readinessTimeout)
 
-	if err := helpers.WaitForContainerReadiness(runtime, containerID, readinessTimeout); err != nil {
+	if err := helpers.WaitForContainerReadiness(ctx, runtime, containerID, readinessTimeout); err != nil {
 		return fmt.Errorf("readiness check failed for container: '%s'!: %w", cInfo.Name, err)
 	}
 	logger.Infof("'%s', '%s', '%s': Readiness Check for the container is completed!\n", podTemplateName, podName, cInfo.Name)
@@ -623,13 +703,17 @@ func doContainerReadinessCheck(runtime runtime.Runtime, podTemplateName, podName
 	return nil
 }
 
-func deployPodAndReadinessCheck(runtime runtime.Runtime, podSpec *models.PodSpec,
+func deployPodAndReadinessCheck(ctx context.Context, runtime runtime.Runtime, podSpec *models.PodSpec,
 	podTemplateName string, body io.Reader, opts map[string]string) error {
-	pods, err := podman.RunPodmanKubePlay(body, opts)
+	kubeReport, err := runtime.CreatePod(ctx, body, opts)
 	if err != nil {
 		return fmt.Errorf("failed pod creation: %w", err)
 	}
 
+	if err := checkKubePlayReport(podTemplateName, kubeReport); err != nil {
+		return fmt.Errorf("failed pod creation: %w", err)
+	}
+
 	logger.Infof("'%s': Successfully ran podman kube play\n", podTemplateName, logger.VerbosityLevelDebug)
 
 	// ---- Pod Readiness Checks ----
@@ -638,8 +722,8 @@ func deployPodAndReadinessCheck(runtime runtime.Runtime, podSpec *models.PodSpec
 		Step2: Perform Containers Readiness Check
 	*/
 
-	for _, pod := range pods {
-		pInfo, err := runtime.InspectPod(pod.ID)
+	for _, pod := range kubeReport.Pods {
+		pInfo, err := runtime.InspectPod(ctx, pod.ID)
 		if err != nil {
 			return fmt.Errorf("failed to do pod inspect for podID: '%s' with error: %w", pod.ID, err)
 		}
@@ -649,13 +733,13 @@ func deployPodAndReadinessCheck(runtime runtime.Runtime, podSpec *models.PodSpec
 		logger.Infof("'%s', '%s': Starting Pod Readiness check...\n", podTemplateName, podName)
 
 		// Step1: ---- Containers Creation Check ----
-		if err := doContainersCreationCheck(runtime, podSpec, podTemplateName, pInfo.Name, pInfo.ID); err != nil {
+		if err := doContainersCreationCheck(ctx, runtime, podSpec, podTemplateName, pInfo.Name, pInfo.ID); err != nil {
 			return err
 		}
 
 		// Step2: ---- Containers Readiness Check ----
 		for _, container := range pInfo.Containers {
-			if err := doContainerReadinessCheck(runtime, podTemplateName, pInfo.Name, container.ID); err != nil {
+			if err := doContainerReadinessCheck(ctx, runtime, podTemplateName, pInfo.Name, container.ID); err != nil {
 				return err
 			}
 			logger.Infoln("-------")
@@ -669,6 +753,87 @@ func deployPodAndReadinessCheck(runtime runtime.Runtime, podSpec *models.PodSpec
 	return nil
 }
 
+// checkKubePlayReport logs the non-fatal messages from the kube play report and
+// returns an error if any of the containers within the deployed pods failed to start.
+func checkKubePlayReport(podTemplateName string, report *types.KubePlayReport) error {
+	var errs []error
+	for _, pod := range report.Pods {
+		for _, log := range pod.Logs {
+			logger.Infof("'%s': %s\n", podTemplateName, strings.TrimSpace(log), logger.VerbosityLevelDebug)
+		}
+		for _, containerErr := range pod.ContainerErrors {
+			errs = append(errs, fmt.Errorf("pod '%s': %s", pod.ID, containerErr))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateHostRequirements verifies the host requirements of the pods yet to be deployed and adds the results to the
+// validation report of the application.
+func validateHostRequirements(ctx context.Context, report *validators.Report, appMetadata *templates.AppMetadata, podSpecs []*models.PodSpec,
+	appName string, skip map[string]bool) error {
+	reqs, err := resolveHostRequirements(appMetadata, podSpecs, appName)
+	if err != nil {
+		return err
+	}
+
+	logger.Infof("Validating the host requirements of application '%s'...\n", appName)
+	err = bootstrap.RunRules(ctx, report, reqs.Rules(host.Local()), skip, validationProfile)
+	storeValidationReport(report, appName)
+	if err != nil {
+		return fmt.Errorf("host requirements validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// storeValidationReport stores the validation report in the application directory for later support.
+func storeValidationReport(report *validators.Report, appName string) {
+	reportPath := filepath.Join(constants.ApplicationsPath, appName, constants.ValidationReportFile)
+	if err := report.WriteJSON(reportPath); err != nil {
+		logger.Warningf("failed to store the validation report: %v\n", err)
+	}
+}
+
+// storeValues stores the values the application is deployed with in the application directory for later support.
+func storeValues(appName string) {
+	data, err := yaml.Marshal(values)
+	if err != nil {
+		logger.Warningf("failed to store the values: %v\n", err)
+
+		return
+	}
+	storeAppFile(appName, constants.ValuesFile, data)
+}
+
+// storeModelRevisions stores the commits of the models the application is deployed with, to detect the models
+// changing on disk later.
+func storeModelRevisions(appName string, models []hf.Ref) {
+	data, err := json.MarshalIndent(modelstore.Deployment(vars.ModelDirectory, models), "", "  ")
+	if err != nil {
+		logger.Warningf("failed to store the model revisions: %v\n", err)
+
+		return
+	}
+	storeAppFile(appName, constants.ModelRevisionsFile, data)
+}
+
+// storeAppFile stores the file in the application directory for later support. The file is readable by its owner only
+// as it may hold secrets.
+func storeAppFile(appName, name string, data []byte) {
+	path := filepath.Join(constants.ApplicationsPath, appName, name)
+	if err := os.MkdirAll(filepath.Dir(path), appDirPermissions); err != nil {
+		logger.Warningf("failed to store %s: %v\n", name, err)
+
+		return
+	}
+
+	if err := os.WriteFile(path, data, appFilePermissions); err != nil {
+		logger.Warningf("failed to store %s: %v\n", name, err)
+	}
+}
+
 func validateSpyreCardRequirements(req int, actual int) error {
 	if actual < req {
 		return fmt.Errorf("insufficient spyre cards. Require: %d spyre cards to proceed", req)
@@ -677,29 +842,59 @@ func validateSpyreCardRequirements(req int, actual int) error {
 	return nil
 }
 
-func calculateReqSpyreCards(client *podman.PodmanClient, tp templates.Template, podTemplateFileNames []string, appTemplateName, appName string) (int, error) {
-	totalReqSpyreCounts := 0
-
-	// Calculate Req Spyre Counts
+// fetchPendingPodSpecs renders the pod specs of the pod templates whose pods are not deployed yet.
+func fetchPendingPodSpecs(ctx context.Context, client *podman.PodmanClient, tp templates.Template, podTemplateFileNames []string, appTemplateName, appName string) ([]*models.PodSpec, error) {
+	podSpecs := make([]*models.PodSpec, 0, len(podTemplateFileNames))
 	for _, podTemplateFileName := range podTemplateFileNames {
 		// fetch pod spec
 		podSpec, err := fetchPodSpec(tp, appTemplateName, podTemplateFileName, appName)
 		if err != nil {
-			return totalReqSpyreCounts, fmt.Errorf("failed to load pod Template: '%s' for appTemplate: '%s' with error: %w", podTemplateFileName, appTemplateName, err)
+			return nil, err
 		}
 
-		// check if pod already exists and skip counting if it does exists
-		exists, err := client.PodExists(podSpec.Name)
+		// check if pod already exists and skip it if it does exists
+		exists, err := client.PodExists(ctx, podSpec.Name)
 		if err != nil {
-			return totalReqSpyreCounts, fmt.Errorf("failed to check pod status: %w", err)
+			return nil, fmt.Errorf("failed to check pod status: %w", err)
 		}
 
 		if exists {
-			logger.Infof("Pod %s already exists, skipping spyre cards calculation", podSpec.Name, logger.VerbosityLevelDebug)
+			logger.Infof("Pod %s already exists, skipping its requirements calculation", podSpec.Name, logger.VerbosityLevelDebug)
 
 			continue
 		}
 
+		podSpecs = append(podSpecs, podSpec)
+	}
+
+	return podSpecs, nil
+}
+
+// validateModelsFit verifies the models served by the application fit the Spyre cards requested for them, before
+// downloading them.
+func validateModelsFit(ctx context.Context, servedModels []templates.Model) error {
+	s := spinner.New("Validating the models fit the Spyre cards...")
+	s.Start(ctx)
+
+	client := hf.NewClient()
+	for _, model := range servedModels {
+		s.UpdateMessage("Validating model: " + model.Ref().String() + "...")
+		if err := helpers.ValidateModelFit(ctx, client, model); err != nil {
+			s.Fail("model " + model.Ref().String() + " does not fit")
+
+			return err
+		}
+	}
+	s.Stop("Models fit the Spyre cards requested")
+
+	return nil
+}
+
+func calculateReqSpyreCards(podSpecs []*models.PodSpec) (int, error) {
+	totalReqSpyreCounts := 0
+
+	// Calculate Req Spyre Counts
+	for _, podSpec := range podSpecs {
 		// fetch the spyreCount for all containers from the annotations
 		spyreCount, _, err := fetchSpyreCardsFromPodAnnotations(podSpec.Annotations)
 		if err != nil {
@@ -1,17 +1,23 @@
 package application
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"strings"
+	"syscall"
 
 	"github.com/spf13/cobra"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/smt"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 )
 
@@ -44,7 +50,14 @@ Arguments
 			return fmt.Errorf("failed to connect to podman: %w", err)
 		}
 
-		err = deleteApplication(runtimeClient, applicationName)
+		// Cancel cleanly on Ctrl+C / SIGTERM and report the state of pods left behind
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		err = deleteApplication(ctx, runtimeClient, applicationName)
+		if ctx.Err() != nil {
+			helpers.ReportInterruptedPods(runtimeClient, applicationName, ctx.Err())
+		}
 		if err != nil {
 			return fmt.Errorf("failed to delete application: %w", err)
 		}
@@ -59,11 +72,11 @@ func init() {
 	deleteCmd.Flags().BoolVarP(&autoYes, "yes", "y", false, "Automatically accept all confirmation prompts (default=false)")
 }
 
-func deleteApplication(client *podman.PodmanClient, appName string) error {
+func deleteApplication(ctx context.Context, client *podman.PodmanClient, appName string) error {
 	appDir := filepath.Join(constants.ApplicationsPath, filepath.Base(appName))
 	appExists := dirExists(appDir)
 
-	pods, err := client.ListPods(map[string][]string{
+	pods, err := client.ListPods(ctx, map[string][]string{
 		"label": {fmt.Sprintf("ai-services.io/application=%s", appName)},
 	})
 	if err != nil {
@@ -73,6 +86,7 @@ func deleteApplication(client *podman.PodmanClient, appName string) error {
 
 	if !podsExists {
 		logger.Infof("No pods found for application: %s\n", appName)
+		releaseSMTLevel(ctx, appName)
 
 		return nil
 	}
@@ -94,10 +108,17 @@ func deleteApplication(client *podman.PodmanClient, appName string) error {
 
 	logger.Infoln("Proceeding with deletion...")
 
-	if err := podsDeletion(client, pods); err != nil {
+	if err := podsDeletion(ctx, client, pods); err != nil {
 		return err
 	}
 
+	// do not cleanup the application data if the deletion was interrupted
+	if ctx.Err() != nil {
+		return fmt.Errorf("deletion interrupted: %w", ctx.Err())
+	}
+
+	releaseSMTLevel(ctx, appName)
+
 	if appExists && !skipCleanup {
 		if err := appDataDeletion(appDir); err != nil {
 			return err
@@ -107,6 +128,14 @@ func deleteApplication(client *podman.PodmanClient, appName string) error {
 	return nil
 }
 
+// releaseSMTLevel releases the SMT level required by the application, restoring the original level if no other
+// application requires it.
+func releaseSMTLevel(ctx context.Context, appName string) {
+	if err := smt.NewManager(host.Local()).Release(ctx, appName); err != nil {
+		logger.Warningf("failed to release the SMT level of application %s: %v, run 'ai-services system smt restore' to restore it\n", appName, err)
+	}
+}
+
 func logPodsToBeDeleted(appName string, pods []runtime.Pod) {
 	logger.Infof("Found %d pods for given applicationName: %s.\n", len(pods), appName)
 	logger.Infoln("Below are the list of pods to be deleted")
@@ -137,13 +166,18 @@ func deleteConfirmation(appName string, podsExists, appExists bool) (bool, error
 	return confirmDelete, nil
 }
 
-func podsDeletion(client *podman.PodmanClient, pods []runtime.Pod) error {
+func podsDeletion(ctx context.Context, client *podman.PodmanClient, pods []runtime.Pod) error {
 	var errors []string
 
 	for _, pod := range pods {
+		// stop deleting further pods once interrupted
+		if ctx.Err() != nil {
+			break
+		}
+
 		logger.Infof("Deleting pod: %s\n", pod.Name)
 
-		if err := client.DeletePod(pod.ID, utils.BoolPtr(true)); err != nil {
+		if err := client.DeletePod(ctx, pod.ID, utils.BoolPtr(true)); err != nil {
 			errors = append(errors, fmt.Sprintf("pod %s: %v", pod.Name, err))
 
 			continue
@@ -0,0 +1,118 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/doctor"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/output"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+var doctorOutput output.Options
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor [name]",
+	Short: "Diagnose the problems of an application",
+	Long: `Inspects every pod and container of the application and detects the common failure signatures:
+  • Containers killed for running out of memory, or exited with an error
+  • Failing healthchecks along with their last output
+  • Model directories missing under the model directory
+  • Ports of the application in use by other processes
+  • Spyre devices (/dev/vfio) missing on the LPAR
+  • vLLM startup errors in the container logs
+
+The findings are ranked by severity along with a hint to remediate them.
+Exits with a non-zero status if any problem is found.
+
+Arguments
+  [name]: Application name (required)
+`,
+	Example: `  # Diagnose the rag application
+  ai-services application doctor rag
+
+  # Print the findings in json format
+  ai-services application doctor rag -o json`,
+	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := doctorOutput.Validate(); err != nil {
+			return err
+		}
+
+		return utils.VerifyAppName(args[0])
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// Once precheck passes, silence usage for any *later* internal errors.
+		cmd.SilenceUsage = true
+
+		runtimeClient, err := podman.NewPodmanClient()
+		if err != nil {
+			return fmt.Errorf("failed to connect to podman: %w", err)
+		}
+
+		return runDoctorCmd(cmd.Context(), runtimeClient, args[0])
+	},
+}
+
+func init() {
+	output.AddFlags(doctorCmd, &doctorOutput)
+}
+
+func runDoctorCmd(ctx context.Context, client *podman.PodmanClient, appName string) error {
+	findings, err := doctor.Diagnose(ctx, client, host.Local(), appName)
+	if err != nil {
+		return fmt.Errorf("failed to diagnose application: %w", err)
+	}
+
+	if err := renderFindings(findings); err != nil {
+		return err
+	}
+
+	problems := 0
+	for _, finding := range findings {
+		if finding.IsProblem() {
+			problems++
+		}
+	}
+	if problems > 0 {
+		return fmt.Errorf("%d problem(s) found in application '%s'", problems, appName)
+	}
+
+	return nil
+}
+
+func renderFindings(findings []doctor.Finding) error {
+	if doctorOutput.IsStructured() {
+		if findings == nil {
+			findings = []doctor.Finding{}
+		}
+
+		return doctorOutput.Print("DoctorFindingList", findings)
+	}
+
+	if len(findings) == 0 {
+		logger.Infoln("No problems found")
+
+		return nil
+	}
+
+	p := utils.NewTableWriter()
+	defer p.CloseTableWriter()
+
+	p.SetHeaders("#", "SEVERITY", "POD/CONTAINER", "FINDING", "HINT")
+	for i, f := range findings {
+		location := f.Pod
+		if f.Container != "" {
+			location = f.Pod + "/" + f.Container
+		}
+		p.AppendRow(strconv.Itoa(i+1), string(f.Severity), location, f.Message, f.Hint)
+	}
+
+	return nil
+}
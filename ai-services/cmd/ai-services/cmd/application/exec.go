@@ -0,0 +1,152 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+var (
+	execPodName       string
+	execContainerName string
+	execInteractive   bool
+	execTty           bool
+)
+
+var execCmd = &cobra.Command{
+	Use:   "exec [name] --pod [pod] [--container container] -- [command]",
+	Short: "Run a command inside an application container",
+	Long: `Runs a command inside a container of the given application pod.
+
+Arguments
+  [name]:    Application name (required)
+  [command]: Command to run along with its arguments, specified after '--' (required)
+
+The pod and container names can be provided either as the full name (Eg:- rag--vllm-server)
+or without the application prefix (Eg:- vllm-server). If no container is provided,
+the first non-infra container of the pod is used.
+`,
+	Example: `  # Open an interactive shell in the first container of the vllm-server pod
+  ai-services application exec rag --pod vllm-server -it -- /bin/bash
+
+  # List the models served by the instruct container
+  ai-services application exec rag --pod vllm-server --container instruct -- ls /models`,
+	Args: cobra.MinimumNArgs(2),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if cmd.ArgsLenAtDash() != 1 {
+			return errors.New("application name must be followed by '--' and the command to run")
+		}
+
+		return utils.VerifyAppName(args[0])
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		applicationName := args[0]
+
+		// Once precheck passes, silence usage for any *later* internal errors.
+		cmd.SilenceUsage = true
+
+		runtimeClient, err := podman.NewPodmanClient()
+		if err != nil {
+			return fmt.Errorf("failed to connect to podman: %w", err)
+		}
+
+		err = execInContainer(cmd.Context(), runtimeClient, applicationName, args[1:])
+		// the command reports its own failure, only its exit code is propagated
+		var exitErr *ExitCodeError
+		if errors.As(err, &exitErr) {
+			cmd.SilenceErrors = true
+		}
+
+		return err
+	},
+}
+
+func init() {
+	execCmd.Flags().StringVar(&execPodName, "pod", "", "Pod name to run the command in (required)")
+	execCmd.Flags().StringVar(&execContainerName, "container", "", "Container name to run the command in (Optional)\nDefaults to the first non-infra container of the pod")
+	execCmd.Flags().BoolVarP(&execInteractive, "interactive", "i", false, "Keep STDIN open for the command")
+	execCmd.Flags().BoolVarP(&execTty, "tty", "t", false, "Allocate a pseudo-TTY for the command")
+	_ = execCmd.MarkFlagRequired("pod")
+}
+
+func execInContainer(ctx context.Context, client *podman.PodmanClient, appName string, command []string) error {
+	podName := qualifyName(appName, execPodName)
+
+	pInfo, err := client.InspectPod(ctx, podName)
+	if err != nil {
+		return fmt.Errorf("failed to find pod '%s': %w", podName, err)
+	}
+
+	if pInfo.Labels[constants.ApplicationAnnotationKey] != appName {
+		return fmt.Errorf("pod '%s' does not belong to application '%s'", podName, appName)
+	}
+
+	containerName, err := resolveExecContainer(pInfo.Name, pInfo.InfraContainerID, pInfo.Containers, execContainerName)
+	if err != nil {
+		return err
+	}
+
+	exitCode, err := client.Exec(ctx, containerName, runtime.ExecOptions{
+		Cmd:         command,
+		Interactive: execInteractive,
+		Tty:         execTty,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to run command in container '%s': %w", containerName, err)
+	}
+
+	if exitCode != 0 {
+		return &ExitCodeError{Code: exitCode}
+	}
+
+	return nil
+}
+
+// ExitCodeError is returned when the command run in the container exits with a non-zero code, which ai-services exits
+// with as well.
+type ExitCodeError struct {
+	Code int
+}
+
+func (e *ExitCodeError) Error() string {
+	return fmt.Sprintf("command exited with code %d", e.Code)
+}
+
+// qualifyName prefixes the name with '<prefix>--' if it is not already prefixed.
+func qualifyName(prefix, name string) string {
+	if strings.HasPrefix(name, prefix+"--") {
+		return name
+	}
+
+	return prefix + "--" + name
+}
+
+// resolveExecContainer returns the container to run the command in.
+// Defaults to the first non-infra container when no container name is provided.
+func resolveExecContainer(podName, infraContainerID string, containers []define.InspectPodContainerInfo, name string) (string, error) {
+	for _, container := range containers {
+		if container.ID == infraContainerID {
+			continue
+		}
+
+		// podman names the pod containers as '<podName>-<containerName>'
+		if name == "" || container.Name == name || container.Name == podName+"-"+name {
+			return container.Name, nil
+		}
+	}
+
+	if name == "" {
+		return "", fmt.Errorf("no containers found in pod '%s'", podName)
+	}
+
+	return "", fmt.Errorf("container '%s' not found in pod '%s'", name, podName)
+}
@@ -5,14 +5,26 @@ import (
 
 	"github.com/project-ai-services/ai-services/internal/pkg/image"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/output"
 	"github.com/spf13/cobra"
 )
 
+var outputOpts output.Options
+
+// imageInfo is the machine-readable representation of a container image used by an application template.
+type imageInfo struct {
+	Image    string `json:"image"`
+	Template string `json:"template"`
+}
+
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List container images for a given application template",
 	Long:  ``,
 	Args:  cobra.MaximumNArgs(0),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return outputOpts.Validate()
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
@@ -21,12 +33,25 @@ var listCmd = &cobra.Command{
 	},
 }
 
+func init() {
+	output.AddFlags(listCmd, &outputOpts)
+}
+
 func list(templateName string) error {
 	images, err := image.ListImages(templateName, "")
 	if err != nil {
 		return fmt.Errorf("error listing images: %w", err)
 	}
 
+	if outputOpts.IsStructured() {
+		imageInfos := make([]imageInfo, 0, len(images))
+		for _, img := range images {
+			imageInfos = append(imageInfos, imageInfo{Image: img, Template: templateName})
+		}
+
+		return outputOpts.Print("ImageList", imageInfos)
+	}
+
 	logger.Infof("Container images for application template '%s' are:\n", templateName)
 	for _, image := range images {
 		logger.Infoln("- " + image)
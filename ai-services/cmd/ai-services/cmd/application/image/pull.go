@@ -1,6 +1,7 @@
 package image
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/image"
@@ -18,11 +19,11 @@ var pullCmd = &cobra.Command{
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
 
-		return pull(templateName)
+		return pull(cmd.Context(), templateName)
 	},
 }
 
-func pull(template string) error {
+func pull(ctx context.Context, template string) error {
 	images, err := image.ListImages(template, "")
 	if err != nil {
 		return fmt.Errorf("error listing images: %w", err)
@@ -35,7 +36,7 @@ func pull(template string) error {
 	}
 
 	for _, image := range images {
-		if err := runtimeClient.PullImage(image); err != nil {
+		if err := runtimeClient.PullImage(ctx, image); err != nil {
 			return fmt.Errorf("failed to pull the image: %w", err)
 		}
 	}
@@ -1,16 +1,28 @@
 package application
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/spf13/cobra"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/output"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
+var infoOutput output.Options
+
+// appInfo is the machine-readable representation of the application info.
+type appInfo struct {
+	Name     string `json:"name"`
+	Template string `json:"template"`
+	Version  string `json:"version"`
+	Info     string `json:"info"`
+}
+
 var infoCmd = &cobra.Command{
 	Use:   "info [name]",
 	Short: "Application info",
@@ -19,6 +31,9 @@ var infoCmd = &cobra.Command{
 		- [name]: Application name (Required)
 	`,
 	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return infoOutput.Validate()
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// fetch application name
 		applicationName := args[0]
@@ -31,7 +46,7 @@ var infoCmd = &cobra.Command{
 			return fmt.Errorf("failed to connect to podman: %w", err)
 		}
 
-		err = runInfoCommamd(runtimeClient, applicationName)
+		err = runInfoCommamd(cmd.Context(), runtimeClient, applicationName)
 		if err != nil {
 			return fmt.Errorf("failed to fetch application info: %w", err)
 		}
@@ -40,7 +55,11 @@ var infoCmd = &cobra.Command{
 	},
 }
 
-func runInfoCommamd(client *podman.PodmanClient, appName string) error {
+func init() {
+	output.AddFlags(infoCmd, &infoOutput)
+}
+
+func runInfoCommamd(ctx context.Context, client *podman.PodmanClient, appName string) error {
 	// Step1: Do List pods and filter for given application name
 
 	listFilters := map[string][]string{}
@@ -48,31 +67,37 @@ func runInfoCommamd(client *podman.PodmanClient, appName string) error {
 		listFilters["label"] = []string{fmt.Sprintf("ai-services.io/application=%s", appName)}
 	}
 
-	pods, err := client.ListPods(listFilters)
+	pods, err := client.ListPods(ctx, listFilters)
 	if err != nil {
 		return fmt.Errorf("failed to list pods: %w", err)
 	}
 
 	// If there exists no pod for given application name, then fail saying application for given application name doesnt exist
 	if len(pods) == 0 {
+		if infoOutput.IsStructured() {
+			return fmt.Errorf("application '%s' does not exist", appName)
+		}
 		logger.Infof("Application: '%s' does not exist.", appName)
 
 		return nil
 	}
 
-	logger.Infoln("Application Name: " + appName)
-
-	// Step2: From one of the pod, fetch and print the template and version label values
+	// Step2: From one of the pod, fetch the template and version label values
 
 	appTemplate := pods[0].Labels[string(vars.TemplateLabel)]
-	logger.Infoln("Application Template: " + appTemplate)
-
 	version := pods[0].Labels[string(vars.VersionLabel)]
+
+	if infoOutput.IsStructured() {
+		return printAppInfo(ctx, client, appName, appTemplate, version)
+	}
+
+	logger.Infoln("Application Name: " + appName)
+	logger.Infoln("Application Template: " + appTemplate)
 	logger.Infoln("Version: " + version)
 
 	// Step3: Read and print the info.md file
 
-	if err := helpers.PrintInfo(client, appName, appTemplate); err != nil {
+	if err := helpers.PrintInfo(ctx, client, appName, appTemplate); err != nil {
 		// not failing if overall info command, if we cannot display Info
 		logger.Errorf("failed to display info: %v\n", err)
 
@@ -81,3 +106,19 @@ func runInfoCommamd(client *podman.PodmanClient, appName string) error {
 
 	return nil
 }
+
+// printAppInfo emits the application info along with the rendered info.md in the requested structured format.
+func printAppInfo(ctx context.Context, client *podman.PodmanClient, appName, appTemplate, version string) error {
+	info, err := helpers.RenderInfo(ctx, client, appName, appTemplate)
+	if err != nil {
+		// not failing if overall info command, if we cannot render Info
+		logger.Errorf("failed to render info: %v\n", err)
+	}
+
+	return infoOutput.Print("ApplicationInfo", appInfo{
+		Name:     appName,
+		Template: appTemplate,
+		Version:  version,
+		Info:     info,
+	})
+}
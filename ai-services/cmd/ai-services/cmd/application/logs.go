@@ -1,6 +1,7 @@
 package application
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
@@ -33,7 +34,7 @@ var logsCmd = &cobra.Command{
 			return fmt.Errorf("failed to connect to podman: %w", err)
 		}
 
-		return showLogs(runtimeClient, podName, containerNameOrID)
+		return showLogs(cmd.Context(), runtimeClient, podName, containerNameOrID)
 	},
 }
 
@@ -43,27 +44,27 @@ func init() {
 	_ = logsCmd.MarkFlagRequired("pod")
 }
 
-func showLogs(client *podman.PodmanClient, podName string, containerNameOrID string) error {
+func showLogs(ctx context.Context, client *podman.PodmanClient, podName string, containerNameOrID string) error {
 	logger.Warningln("Press Ctrl+C to exit the logs and return to the terminal.")
 	logger.Infof("Fetching logs for application pod: %s", podName)
 
 	if containerNameOrID == "" {
-		if err := client.PodLogs(podName); err != nil {
+		if err := client.PodLogs(ctx, podName); err != nil {
 			return fmt.Errorf("failed to fetch pod: %s logs; err: %w", podName, err)
 		}
 
 		return nil
 	}
 
-	if err := fetchContainerLogs(client, containerNameOrID); err != nil {
+	if err := fetchContainerLogs(ctx, client, containerNameOrID); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func fetchContainerLogs(client *podman.PodmanClient, containerNameOrID string) error {
-	exists, err := client.ContainerExists(containerNameOrID)
+func fetchContainerLogs(ctx context.Context, client *podman.PodmanClient, containerNameOrID string) error {
+	exists, err := client.ContainerExists(ctx, containerNameOrID)
 	if err != nil {
 		return err
 	}
@@ -71,7 +72,7 @@ func fetchContainerLogs(client *podman.PodmanClient, containerNameOrID string) e
 		return fmt.Errorf("container %s doesn't exists", containerNameOrID)
 	}
 	logger.Infof("Fetching logs for container: %s", containerNameOrID)
-	err = client.ContainerLogs(containerNameOrID)
+	err = client.ContainerLogs(ctx, containerNameOrID)
 	if err != nil {
 		return fmt.Errorf("failed to fetch container: %s logs; err: %w", containerNameOrID, err)
 	}
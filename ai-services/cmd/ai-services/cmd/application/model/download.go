@@ -1,10 +1,12 @@
 package model
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 	"github.com/spf13/cobra"
 )
@@ -12,36 +14,43 @@ import (
 var downloadCmd = &cobra.Command{
 	Use:   "download",
 	Short: "Download models for a given application template",
-	Long:  ``,
-	Args:  cobra.MaximumNArgs(0),
+	Long: `Downloads the models of the application template from the Hugging Face Hub into the model directory.
+The files already downloaded are skipped and the incomplete downloads are resumed. Each file is verified against
+its checksum once downloaded.
+
+The HF_ENDPOINT env var overrides the Hugging Face Hub endpoint, Eg:- to use a mirror.
+The HF_TOKEN env var sets the access token for the private and the gated models.`,
+	Args: cobra.MaximumNArgs(0),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
 		hiddenTemplates, _ = cmd.Flags().GetBool("hidden")
 
-		return download(cmd)
+		return download(cmd.Context())
 	},
 }
 
 func init() {
 	downloadCmd.Flags().StringVarP(&templateName, "template", "t", "", "Application template name(Required)")
 	_ = downloadCmd.MarkFlagRequired("template")
-	downloadCmd.Flags().StringVar(&vars.ToolImage, "tool-image", vars.ToolImage, "Tool container image used for downloading the model (for development purposes only)")
-	_ = downloadCmd.Flags().MarkHidden("tool-image")
-	downloadCmd.Flags().StringVar(&vars.ModelDirectory, "dir", vars.ModelDirectory, "Directory to download the model files")
 }
 
-func download(cmd *cobra.Command) error {
+func download(ctx context.Context) error {
 	models, err := models(templateName)
 	if err != nil {
 		return err
 	}
-	logger.Infoln("Downloaded Models in application template" + templateName + ":")
+	logger.Infoln("Downloading models in application template " + templateName + ":")
 	for _, model := range models {
-		err := helpers.DownloadModel(model, vars.ModelDirectory)
+		s := spinner.New("Downloading model: " + model.String() + "...")
+		s.Start(ctx)
+		err := helpers.DownloadModel(ctx, model, vars.ModelDirectory, helpers.ModelDownloadProgress(model.String(), s.UpdateMessage))
 		if err != nil {
+			s.Fail("failed to download model: " + model.String())
+
 			return fmt.Errorf("failed to download model: %w", err)
 		}
+		s.Stop("Downloaded model: " + model.String())
 	}
 
 	return nil
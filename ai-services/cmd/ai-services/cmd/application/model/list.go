@@ -1,31 +1,90 @@
 package model
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/docker/go-units"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/modelstore"
+	"github.com/project-ai-services/ai-services/internal/pkg/output"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 	"github.com/spf13/cobra"
 )
 
-var templateName string
+const (
+	// shortCommitLength is the length of the commits displayed.
+	shortCommitLength = 7
+	// none is displayed for the empty columns.
+	none = "-"
+)
+
+var (
+	templateName string
+	outputOpts   output.Options
+	listLocal    bool
+)
+
+// modelInfo is the machine-readable representation of a model used by an application template.
+type modelInfo struct {
+	Name string `json:"name"`
+	// Revision is the revision the template references, Eg:- a commit pinning the model
+	Revision string `json:"revision,omitempty"`
+	Template string `json:"template"`
+}
+
+// localModelInfo is the machine-readable representation of a model present in the model directory.
+type localModelInfo struct {
+	modelstore.Model
+	references
+}
 
 var listCmd = &cobra.Command{
 	Use:   "list",
-	Short: "List models for a given application template",
-	Long:  ``,
-	Args:  cobra.MaximumNArgs(0),
+	Short: "List models for a given application template, or the models downloaded locally",
+	Long: `Lists the models used by the given application template.
+
+With --local, lists the models present in the model directory along with their size, their revision, when an
+application was last created using them, and the templates and the deployed applications referencing them.`,
+	Example: `  # List the models of the rag template
+  ai-services application model list -t rag
+
+  # List the models downloaded locally
+  ai-services application model list --local`,
+	Args: cobra.MaximumNArgs(0),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if listLocal && templateName != "" {
+			return errors.New("--local and --template flags cannot be used together")
+		}
+		if !listLocal && templateName == "" {
+			return errors.New(`required flag(s) "template" not set`)
+		}
+
+		return outputOpts.Validate()
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
 		hiddenTemplates, _ = cmd.Flags().GetBool("hidden")
 
+		if listLocal {
+			return listLocalModels(cmd.Context())
+		}
+
 		return list(cmd)
 	},
 }
 
 func init() {
-	listCmd.Flags().StringVarP(&templateName, "template", "t", "", "Application template name (Required)")
-	_ = listCmd.MarkFlagRequired("template")
+	listCmd.Flags().StringVarP(&templateName, "template", "t", "", "Application template name (Required unless --local is set)")
+	listCmd.Flags().BoolVar(&listLocal, "local", false, "List the models downloaded locally in the model directory")
+	output.AddFlags(listCmd, &outputOpts)
 }
 
 func list(cmd *cobra.Command) error {
@@ -33,10 +92,110 @@ func list(cmd *cobra.Command) error {
 	if err != nil {
 		return fmt.Errorf("failed to list the models, err: %w", err)
 	}
+
+	if outputOpts.IsStructured() {
+		modelInfos := make([]modelInfo, 0, len(models))
+		for _, model := range models {
+			modelInfos = append(modelInfos, modelInfo{Name: model.Repo, Revision: model.Revision, Template: templateName})
+		}
+
+		return outputOpts.Print("ModelList", modelInfos)
+	}
+
 	logger.Infoln("Models in application template " + templateName + ":")
 	for _, model := range models {
-		logger.Infoln("- " + model)
+		logger.Infoln("- " + model.String())
+	}
+
+	return nil
+}
+
+func listLocalModels(ctx context.Context) error {
+	models, err := modelstore.List(vars.ModelDirectory)
+	if err != nil {
+		return err
+	}
+
+	// the models are still listed without the deployed applications if podman is not reachable
+	var rt runtime.Runtime
+	if client, err := podman.NewPodmanClient(); err != nil {
+		logger.Warningf("failed to connect to podman, the deployed applications are not listed: %v\n", err)
+	} else {
+		rt = client
+	}
+
+	refs, err := fetchReferences(ctx, rt)
+	if err != nil {
+		return err
+	}
+
+	infos := make([]localModelInfo, 0, len(models))
+	for _, model := range models {
+		info := localModelInfo{Model: model, references: references{Templates: []string{}, Applications: []string{}}}
+		if ref := refs[model.Name]; ref != nil {
+			info.references = *ref
+		}
+		infos = append(infos, info)
+	}
+
+	if outputOpts.IsStructured() {
+		return outputOpts.Print("LocalModelList", infos)
+	}
+
+	if len(infos) == 0 {
+		logger.Infoln("No models found in " + vars.ModelDirectory)
+
+		return nil
+	}
+
+	p := utils.NewTableWriter()
+	defer p.CloseTableWriter()
+
+	p.SetHeaders("MODEL", "SIZE", "REVISION", "LAST USED", "TEMPLATES", "APPLICATIONS")
+	for _, info := range infos {
+		p.AppendRow(
+			info.Name,
+			units.HumanSize(float64(info.Size)),
+			formatRevision(info.Revision, info.Commit),
+			formatLastUsed(info.LastUsed),
+			joinOrNone(info.Templates),
+			joinOrNone(info.Applications),
+		)
 	}
 
 	return nil
 }
+
+// formatRevision returns the revision along with the short commit it resolved to, Eg:- main (0a1b2c3).
+func formatRevision(revision, commit string) string {
+	if len(commit) > shortCommitLength {
+		commit = commit[:shortCommitLength]
+	}
+
+	switch {
+	case revision != "" && commit != "":
+		return fmt.Sprintf("%s (%s)", revision, commit)
+	case commit != "":
+		return commit
+	case revision != "":
+		return revision
+	}
+
+	return none
+}
+
+func formatLastUsed(lastUsed *time.Time) string {
+	if lastUsed == nil {
+		return none
+	}
+
+	return units.HumanDuration(time.Since(*lastUsed)) + " ago"
+}
+
+func joinOrNone(items []string) string {
+	if len(items) == 0 {
+		return none
+	}
+
+	return strings.Join(items, ", ")
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/hf"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 	"github.com/spf13/cobra"
 )
 
@@ -24,9 +26,13 @@ var hiddenTemplates bool
 func init() {
 	ModelCmd.AddCommand(listCmd)
 	ModelCmd.AddCommand(downloadCmd)
+	ModelCmd.AddCommand(verifyCmd)
+	ModelCmd.AddCommand(pruneCmd)
+	ModelCmd.AddCommand(statusCmd)
+	ModelCmd.PersistentFlags().StringVar(&vars.ModelDirectory, "dir", vars.ModelDirectory, "Directory holding the model files")
 }
 
-func models(template string) ([]string, error) {
+func models(template string) ([]hf.Ref, error) {
 	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
 	apps, err := tp.ListApplications(hiddenTemplates)
 	if err != nil {
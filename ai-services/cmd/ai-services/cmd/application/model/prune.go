@@ -0,0 +1,118 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/modelstore"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+var (
+	pruneDryRun bool
+	pruneYes    bool
+)
+
+var pruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Remove the models no longer used",
+	Long: `Removes the models in the model directory which no application template and no deployed application reference,
+Eg:- the previous revisions of the granite models, to reclaim their disk space.`,
+	Example: `  # List the models which would be removed
+  ai-services application model prune --dry-run
+
+  # Remove the models no longer used without prompting
+  ai-services application model prune -y`,
+	Args: cobra.MaximumNArgs(0),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// Once precheck passes, silence usage for any *later* internal errors.
+		cmd.SilenceUsage = true
+
+		return prune(cmd.Context())
+	},
+}
+
+func init() {
+	pruneCmd.Flags().BoolVar(&pruneDryRun, "dry-run", false, "List the models which would be removed without removing them")
+	pruneCmd.Flags().BoolVarP(&pruneYes, "yes", "y", false, "Automatically accept all confirmation prompts (default=false)")
+}
+
+func prune(ctx context.Context) error {
+	models, err := modelstore.List(vars.ModelDirectory)
+	if err != nil {
+		return err
+	}
+
+	// the deployed applications must be known to not remove a model in use
+	client, err := podman.NewPodmanClient()
+	if err != nil {
+		return fmt.Errorf("failed to connect to podman: %w", err)
+	}
+
+	refs, err := fetchReferences(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	var unused []modelstore.Model
+	var reclaimable uint64
+	for _, model := range models {
+		if refs[model.Name].isEmpty() {
+			unused = append(unused, model)
+			reclaimable += model.Size
+		}
+	}
+
+	if len(unused) == 0 {
+		logger.Infoln("No unused models found")
+
+		return nil
+	}
+
+	p := utils.NewTableWriter()
+	p.SetHeaders("MODEL", "SIZE", "REVISION", "LAST USED")
+	for _, model := range unused {
+		p.AppendRow(model.Name, units.HumanSize(float64(model.Size)), formatRevision(model.Revision, model.Commit), formatLastUsed(model.LastUsed))
+	}
+	p.CloseTableWriter()
+
+	if pruneDryRun {
+		logger.Infof("%d unused model(s) would be removed, reclaiming %s\n", len(unused), units.HumanSize(float64(reclaimable)))
+
+		return nil
+	}
+
+	if !pruneYes {
+		confirmed, err := utils.ConfirmAction(fmt.Sprintf("Are you sure you want to remove the above %d model(s)? ", len(unused)))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			logger.Infoln("Prune cancelled")
+
+			return nil
+		}
+	}
+
+	var errs []error
+	var reclaimed uint64
+	for _, model := range unused {
+		if err := modelstore.Remove(vars.ModelDirectory, model.Name); err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+		reclaimed += model.Size
+		logger.Infof("Removed model %s\n", model.Name)
+	}
+	logger.Infof("Reclaimed %s\n", units.HumanSize(float64(reclaimed)))
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,91 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// references holds the application templates and the deployed applications using a model.
+type references struct {
+	Templates    []string `json:"templates"`
+	Applications []string `json:"applications"`
+}
+
+func (r *references) isEmpty() bool {
+	return r == nil || (len(r.Templates) == 0 && len(r.Applications) == 0)
+}
+
+// fetchReferences returns the references of each model by its name, including the hidden templates.
+// The deployed applications are skipped if the runtime is nil.
+func fetchReferences(ctx context.Context, rt runtime.Runtime) (map[string]*references, error) {
+	refs := map[string]*references{}
+	ref := func(model string) *references {
+		if refs[model] == nil {
+			refs[model] = &references{Templates: []string{}, Applications: []string{}}
+		}
+
+		return refs[model]
+	}
+
+	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	tmpls, err := tp.ListApplications(true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the application templates: %w", err)
+	}
+	for _, tmpl := range tmpls {
+		models, err := helpers.ListModels(tmpl, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, model := range models {
+			if r := ref(model.Repo); !slices.Contains(r.Templates, tmpl) {
+				r.Templates = append(r.Templates, tmpl)
+			}
+		}
+	}
+
+	if rt == nil {
+		return refs, nil
+	}
+
+	pods, err := rt.ListPods(ctx, map[string][]string{"label": {constants.ApplicationAnnotationKey}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	// Key -> application name, Value -> template the application is deployed from
+	apps := map[string]string{}
+	for _, pod := range pods {
+		if app := pod.Labels[constants.ApplicationAnnotationKey]; app != "" && apps[app] == "" {
+			apps[app] = pod.Labels[string(vars.TemplateLabel)]
+		}
+	}
+	for app, tmpl := range apps {
+		if tmpl == "" {
+			continue
+		}
+		models, err := helpers.ListModels(tmpl, app)
+		if err != nil {
+			return nil, err
+		}
+		for _, model := range models {
+			if r := ref(model.Repo); !slices.Contains(r.Applications, app) {
+				r.Applications = append(r.Applications, app)
+			}
+		}
+	}
+
+	for _, r := range refs {
+		slices.Sort(r.Templates)
+		slices.Sort(r.Applications)
+	}
+
+	return refs, nil
+}
@@ -0,0 +1,267 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/hf"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/modelstore"
+	"github.com/project-ai-services/ai-services/internal/pkg/output"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// States of a model used by a deployed application.
+const (
+	// StateOK means the model on disk is at the expected commit.
+	StateOK = "ok"
+	// StateDrifted means the model on disk is at another commit than the one pinned or deployed.
+	StateDrifted = "drifted"
+	// StateMissing means the model is not on disk.
+	StateMissing = "missing"
+	// StateUnknown means the commit expected or the commit on disk is not known, Eg:- the model was not downloaded by
+	// ai-services or the application was deployed before the revisions were recorded.
+	StateUnknown = "unknown"
+)
+
+var statusOutput output.Options
+
+// modelStatus is the machine-readable representation of the state of a model used by a deployed application.
+type modelStatus struct {
+	Application string `json:"application"`
+	Model       string `json:"model"`
+	// Revision is the revision the template references, Eg:- a commit pinning the model, empty for the default revision
+	Revision string `json:"revision,omitempty"`
+	// Expected is the commit expected on disk, the pinned commit or the commit the application was deployed with
+	Expected string `json:"expected,omitempty"`
+	// OnDisk is the commit of the model files on disk
+	OnDisk string `json:"onDisk,omitempty"`
+	State  string `json:"state"`
+}
+
+var statusCmd = &cobra.Command{
+	Use:   "status [name]",
+	Short: "Detect the models changed on disk under the deployed applications",
+	Long: `Compares the commit of the models on disk with the commit expected by each deployed application, that is the
+commit the template pins the model to (repo@commit), or else the commit the application was deployed with.
+Flags the models which drifted, Eg:- re-downloaded at a newer revision, or which are missing.
+Checks all the deployed applications if no name is provided.
+Exits with a non-zero status if any model drifted or is missing.
+
+Arguments
+  [name]: Application name (optional)
+`,
+	Example: `  # Check the models of all the deployed applications
+  ai-services application model status
+
+  # Check the models of the rag application
+  ai-services application model status rag`,
+	Args: cobra.MaximumNArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := statusOutput.Validate(); err != nil {
+			return err
+		}
+
+		if len(args) > 0 {
+			return utils.VerifyAppName(args[0])
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// Once precheck passes, silence usage for any *later* internal errors.
+		cmd.SilenceUsage = true
+
+		var appName string
+		if len(args) > 0 {
+			appName = args[0]
+		}
+
+		client, err := podman.NewPodmanClient()
+		if err != nil {
+			return fmt.Errorf("failed to connect to podman: %w", err)
+		}
+
+		return status(cmd.Context(), client, appName)
+	},
+}
+
+func init() {
+	output.AddFlags(statusCmd, &statusOutput)
+}
+
+func status(ctx context.Context, rt runtime.Runtime, appName string) error {
+	apps, err := deployedApplications(ctx, rt, appName)
+	if err != nil {
+		return err
+	}
+	if appName != "" && len(apps) == 0 {
+		return fmt.Errorf("application '%s' does not exist", appName)
+	}
+
+	statuses := []modelStatus{}
+	for _, app := range slices.Sorted(maps.Keys(apps)) {
+		appStatuses, err := applicationModelStatus(app, apps[app])
+		if err != nil {
+			return err
+		}
+		statuses = append(statuses, appStatuses...)
+	}
+
+	if err := renderStatus(statuses); err != nil {
+		return err
+	}
+
+	problems := 0
+	for _, s := range statuses {
+		if s.State == StateDrifted || s.State == StateMissing {
+			problems++
+		}
+	}
+	if problems > 0 {
+		logger.Infoln("Download the expected revision again using `ai-services application model download -t <template>`, or recreate the application to use the models on disk")
+
+		return fmt.Errorf("%d model(s) drifted or missing", problems)
+	}
+
+	return nil
+}
+
+// deployedApplications returns the template of each deployed application by the application name.
+func deployedApplications(ctx context.Context, rt runtime.Runtime, appName string) (map[string]string, error) {
+	label := constants.ApplicationAnnotationKey
+	if appName != "" {
+		label = fmt.Sprintf("%s=%s", constants.ApplicationAnnotationKey, appName)
+	}
+
+	pods, err := rt.ListPods(ctx, map[string][]string{"label": {label}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	apps := map[string]string{}
+	for _, pod := range pods {
+		if app := pod.Labels[constants.ApplicationAnnotationKey]; app != "" && apps[app] == "" {
+			apps[app] = pod.Labels[string(vars.TemplateLabel)]
+		}
+	}
+
+	return apps, nil
+}
+
+// applicationModelStatus returns the state of each model of the application.
+func applicationModelStatus(app, template string) ([]modelStatus, error) {
+	if template == "" {
+		logger.Warningf("skipping application '%s': the template it is deployed from is unknown\n", app)
+
+		return nil, nil
+	}
+
+	refs, err := helpers.ListModels(template, app)
+	if err != nil {
+		return nil, err
+	}
+
+	deployed, err := readDeployedModels(app)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]modelStatus, 0, len(refs))
+	for _, ref := range refs {
+		statuses = append(statuses, modelStatusOf(app, ref, deployed[ref.Repo]))
+	}
+
+	return statuses, nil
+}
+
+func modelStatusOf(app string, ref hf.Ref, deployed modelstore.Deployed) modelStatus {
+	s := modelStatus{Application: app, Model: ref.Repo, Revision: ref.Revision, Expected: deployed.Commit}
+	if ref.IsPinned() {
+		s.Expected = ref.Revision
+	}
+
+	modelDir := filepath.Join(vars.ModelDirectory, ref.Repo)
+	if entries, err := os.ReadDir(modelDir); err != nil || len(entries) == 0 {
+		s.State = StateMissing
+
+		return s
+	}
+
+	s.OnDisk = modelstore.Commit(modelDir)
+	switch {
+	case s.Expected == "" || s.OnDisk == "":
+		s.State = StateUnknown
+	case s.Expected != s.OnDisk:
+		s.State = StateDrifted
+	default:
+		s.State = StateOK
+	}
+
+	return s
+}
+
+// readDeployedModels returns the models recorded when the application was deployed by the model name.
+func readDeployedModels(app string) (map[string]modelstore.Deployed, error) {
+	deployed := map[string]modelstore.Deployed{}
+	data, err := os.ReadFile(filepath.Join(constants.ApplicationsPath, app, constants.ModelRevisionsFile))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return deployed, nil
+		}
+
+		return nil, fmt.Errorf("failed to read the model revisions of application '%s': %w", app, err)
+	}
+
+	var models []modelstore.Deployed
+	if err := json.Unmarshal(data, &models); err != nil {
+		return nil, fmt.Errorf("failed to decode the model revisions of application '%s': %w", app, err)
+	}
+	for _, model := range models {
+		deployed[model.Model] = model
+	}
+
+	return deployed, nil
+}
+
+func renderStatus(statuses []modelStatus) error {
+	if statusOutput.IsStructured() {
+		return statusOutput.Print("ModelStatusList", statuses)
+	}
+
+	if len(statuses) == 0 {
+		logger.Infoln("No deployed applications found")
+
+		return nil
+	}
+
+	p := utils.NewTableWriter()
+	defer p.CloseTableWriter()
+
+	p.SetHeaders("APPLICATION", "MODEL", "PINNED", "EXPECTED", "ON DISK", "STATE")
+	for _, s := range statuses {
+		pinned := none
+		if ref := (hf.Ref{Repo: s.Model, Revision: s.Revision}); ref.IsPinned() {
+			pinned = formatRevision("", ref.Revision)
+		} else if ref.Revision != "" {
+			pinned = ref.Revision
+		}
+		p.AppendRow(s.Application, s.Model, pinned, formatRevision("", s.Expected), formatRevision("", s.OnDisk), s.State)
+	}
+
+	return nil
+}
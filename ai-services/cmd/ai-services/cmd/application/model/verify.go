@@ -0,0 +1,102 @@
+package model
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/modelstore"
+	"github.com/project-ai-services/ai-services/internal/pkg/output"
+	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+var verifyOutput output.Options
+
+// verifyResult is the machine-readable representation of the verification of a model.
+type verifyResult struct {
+	Name     string               `json:"name"`
+	Problems []modelstore.Problem `json:"problems"`
+}
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify [model]",
+	Short: "Verify the integrity of a downloaded model",
+	Long: `Verifies the files of the model in the model directory against the manifest recorded when it was downloaded.
+Reports the files missing, truncated or corrupted, which are then fetched again by the next download.
+Exits with a non-zero status if any file fails the verification.
+
+Arguments
+  [model]: Model name, Eg:- ibm-granite/granite-3.3-8b-instruct (required)
+`,
+	Example: `  # Verify the granite model
+  ai-services application model verify ibm-granite/granite-3.3-8b-instruct`,
+	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if !filepath.IsLocal(filepath.FromSlash(args[0])) {
+			return fmt.Errorf("invalid model name '%s'", args[0])
+		}
+
+		return verifyOutput.Validate()
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// Once precheck passes, silence usage for any *later* internal errors.
+		cmd.SilenceUsage = true
+
+		return verify(cmd, args[0])
+	},
+}
+
+func init() {
+	output.AddFlags(verifyCmd, &verifyOutput)
+}
+
+func verify(cmd *cobra.Command, model string) error {
+	modelDir := filepath.Join(vars.ModelDirectory, filepath.FromSlash(model))
+	if !utils.FileExists(modelDir) {
+		return fmt.Errorf("model %s is not found in %s", model, vars.ModelDirectory)
+	}
+
+	s := spinner.New("Verifying model: " + model + "...")
+	s.Start(cmd.Context())
+	problems, err := modelstore.Verify(modelDir, func(path string) {
+		s.UpdateMessage("Verifying model: " + model + "... " + path)
+	})
+	if err != nil {
+		s.Fail("failed to verify model: " + model)
+
+		return err
+	}
+	if len(problems) > 0 {
+		s.Fail(fmt.Sprintf("%d file(s) of model %s failed the verification", len(problems), model))
+	} else {
+		s.Stop("Model " + model + " verified successfully")
+	}
+
+	if verifyOutput.IsStructured() {
+		if err := verifyOutput.Print("ModelVerification", verifyResult{Name: model, Problems: problems}); err != nil {
+			return err
+		}
+	} else if len(problems) > 0 {
+		p := utils.NewTableWriter()
+		p.SetHeaders("FILE", "PROBLEM")
+		for _, problem := range problems {
+			p.AppendRow(problem.Path, problem.Reason)
+		}
+		p.CloseTableWriter()
+	}
+
+	if len(problems) > 0 {
+		if err := modelstore.Invalidate(modelDir, problems); err != nil {
+			logger.Warningf("failed to reset the download of the files: %v\n", err)
+		}
+		logger.Infoln("Download the model again using `ai-services application model download -t <template>` to repair it")
+
+		return fmt.Errorf("model %s failed the verification", model)
+	}
+
+	return nil
+}
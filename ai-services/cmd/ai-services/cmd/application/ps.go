@@ -1,34 +1,51 @@
 package application
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/domain/entities/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/connection"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/output"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 	"github.com/spf13/cobra"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
 )
 
-var output string
+const formatWide = "wide"
+
+var (
+	psOutput       output.Options
+	allConnections bool
+)
+
+// podInfo is the machine-readable representation of an application pod.
+type podInfo struct {
+	Host        string    `json:"host,omitempty"`
+	Application string    `json:"application"`
+	PodID       string    `json:"podID"`
+	PodName     string    `json:"podName"`
+	Status      string    `json:"status"`
+	Created     time.Time `json:"created"`
+	Exposed     []string  `json:"exposed"`
+	Containers  []string  `json:"containers"`
+}
 
 func init() {
-	psCmd.Flags().StringVarP(
-		&output,
-		"output",
-		"o",
-		"",
-		"Output format (e.g., wide)",
-	)
+	output.AddFlags(psCmd, &psOutput, formatWide)
+	psCmd.Flags().BoolVar(&allConnections, "all-connections", false, "List applications across all the named connections (see 'ai-services connection list')")
 }
 
 func isOutputWide() bool {
-	return strings.ToLower(output) == "wide"
+	return psOutput.Is(formatWide)
 }
 
 var psCmd = &cobra.Command{
@@ -40,6 +57,13 @@ Arguments
   [name]: Application name (optional)
 `,
 	Args: cobra.MaximumNArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if allConnections && vars.Connection != "" {
+			return fmt.Errorf("--all-connections and --connection flags cannot be used together")
+		}
+
+		return psOutput.Validate()
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
@@ -50,12 +74,12 @@ Arguments
 		}
 
 		// podman connectivity
-		runtimeClient, err := podman.NewPodmanClient()
+		runtimeClients, err := fetchPsClients()
 		if err != nil {
 			return fmt.Errorf("failed to connect to podman: %w", err)
 		}
 
-		err = runPsCmd(runtimeClient, applicationName)
+		err = runPsCmd(cmd.Context(), runtimeClients, applicationName)
 		if err != nil {
 			return fmt.Errorf("failed to fetch application: %w", err)
 		}
@@ -64,20 +88,79 @@ Arguments
 	},
 }
 
-func runPsCmd(runtimeClient *podman.PodmanClient, appName string) error {
-	// filter and fetch pods based on appName
-	pods, err := fetchFilteredPods(runtimeClient, appName)
+// fetchPsClients - returns the podman clients to list the applications from.
+// If --all-connections flag is set, returns a client for each reachable named connection.
+func fetchPsClients() ([]*podman.PodmanClient, error) {
+	if !allConnections {
+		runtimeClient, err := podman.NewPodmanClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return []*podman.PodmanClient{runtimeClient}, nil
+	}
+
+	cfg, err := connection.Load()
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	conns := cfg.List()
+	if len(conns) == 0 {
+		return nil, fmt.Errorf("no connections found. Please use 'ai-services connection add' to add a connection")
+	}
+
+	runtimeClients := make([]*podman.PodmanClient, 0, len(conns))
+	for _, conn := range conns {
+		runtimeClient, err := podman.NewPodmanClientForConnection(conn)
+		if err != nil {
+			// log and skip the unreachable connection
+			logger.Errorf("failed to connect to '%s': %v\n", conn.Name, err)
+
+			continue
+		}
+		runtimeClients = append(runtimeClients, runtimeClient)
+	}
+
+	return runtimeClients, nil
+}
+
+func runPsCmd(ctx context.Context, runtimeClients []*podman.PodmanClient, appName string) error {
+	// filter and fetch pods based on appName for each of the clients
+	podsByClient := make([][]runtime.Pod, len(runtimeClients))
+	totalPods := 0
+	for i, runtimeClient := range runtimeClients {
+		pods, err := fetchFilteredPods(ctx, runtimeClient, appName)
+		if err != nil {
+			if !allConnections {
+				return err
+			}
+			// log and skip the connection if listing fails
+			logger.Errorf("failed to list pods on '%s': %v\n", runtimeClient.Host, err)
+
+			continue
+		}
+		podsByClient[i] = pods
+		totalPods += len(pods)
 	}
 
 	// if no pods are present and also if appName is provided then simply log and return
-	if len(pods) == 0 && appName != "" {
+	if totalPods == 0 && appName != "" && !psOutput.IsStructured() {
 		logger.Infof("No Pods found for the given application name: %s", appName)
 
 		return nil
 	}
 
+	// process each pod to get the required info
+	podInfos := make([]podInfo, 0, totalPods)
+	for i, runtimeClient := range runtimeClients {
+		podInfos = append(podInfos, buildPodInfos(ctx, runtimeClient, podsByClient[i])...)
+	}
+
+	if psOutput.IsStructured() {
+		return psOutput.Print("ApplicationPodList", podInfos)
+	}
+
 	// fetch the table writter object
 	p := utils.NewTableWriter()
 	defer p.CloseTableWriter()
@@ -86,18 +169,20 @@ func runPsCmd(runtimeClient *podman.PodmanClient, appName string) error {
 	setTableHeaders(p)
 
 	// render each pod info as rows in the table
-	renderPodRows(runtimeClient, p, pods)
+	for _, info := range podInfos {
+		p.AppendRow(buildPodRow(info)...)
+	}
 
 	return nil
 }
 
-func fetchFilteredPods(client *podman.PodmanClient, appName string) ([]runtime.Pod, error) {
+func fetchFilteredPods(ctx context.Context, client *podman.PodmanClient, appName string) ([]runtime.Pod, error) {
 	listFilters := map[string][]string{}
 	if appName != "" {
 		listFilters["label"] = []string{fmt.Sprintf("ai-services.io/application=%s", appName)}
 	}
 
-	pods, err := client.ListPods(listFilters)
+	pods, err := client.ListPods(ctx, listFilters)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list pods: %w", err)
 	}
@@ -107,69 +192,96 @@ func fetchFilteredPods(client *podman.PodmanClient, appName string) ([]runtime.P
 
 // setTableHeaders - sets and renders the table header based on the wide options flag set (-o wide).
 func setTableHeaders(p *utils.Printer) {
+	var headers []string
 	if isOutputWide() {
-		p.SetHeaders("APPLICATION NAME", "POD ID", "POD NAME", "STATUS", "CREATED", "EXPOSED", "CONTAINERS")
+		headers = []string{"APPLICATION NAME", "POD ID", "POD NAME", "STATUS", "CREATED", "EXPOSED", "CONTAINERS"}
 	} else {
-		p.SetHeaders("APPLICATION NAME", "POD NAME", "STATUS")
+		headers = []string{"APPLICATION NAME", "POD NAME", "STATUS"}
 	}
-}
 
-// renderPodRows - renders each pod rows on the table.
-func renderPodRows(runtimeClient *podman.PodmanClient, p *utils.Printer, pods []runtime.Pod) {
-	for _, pod := range pods {
-		processAndAppendPodRow(runtimeClient, p, pod)
+	// prepend the host column when listing across all the connections
+	if allConnections {
+		headers = append([]string{"HOST"}, headers...)
 	}
+
+	p.SetHeaders(headers...)
 }
 
-// processAndAppendPodRow - processes the pod to get the required info.
-// Builds and appends the row containing pod info on to the table.
-func processAndAppendPodRow(runtimeClient *podman.PodmanClient, p *utils.Printer, pod runtime.Pod) {
-	appName := fetchPodNameFromLabels(pod.Labels)
-	if appName == "" {
-		// skip pods which are not linked to ai-services
-		return
-	}
+// buildPodInfos - builds the pod info of each of the application pods.
+func buildPodInfos(ctx context.Context, runtimeClient *podman.PodmanClient, pods []runtime.Pod) []podInfo {
+	podInfos := make([]podInfo, 0, len(pods))
+	for _, pod := range pods {
+		appName := fetchPodNameFromLabels(pod.Labels)
+		if appName == "" {
+			// skip pods which are not linked to ai-services
+			continue
+		}
 
-	// do pod inspect
-	pInfo, err := runtimeClient.InspectPod(pod.ID)
-	if err != nil {
-		// log and skip pod if inspect failed
-		logger.Errorf("Failed to do pod inspect: '%s' with error: %v", pod.ID, err)
+		// do pod inspect
+		pInfo, err := runtimeClient.InspectPod(ctx, pod.ID)
+		if err != nil {
+			// log and skip pod if inspect failed
+			logger.Errorf("Failed to do pod inspect: '%s' with error: %v", pod.ID, err)
+
+			continue
+		}
 
-		return
+		podInfos = append(podInfos, buildPodInfo(ctx, runtimeClient, appName, pod, pInfo))
 	}
 
-	// fetch pod row
-	rows := buildPodRow(runtimeClient, appName, pod, pInfo)
-	// append pod row to the table
-	p.AppendRow(rows...)
+	return podInfos
 }
 
-// buildPodRow - builds the row using the pod info based on the wide options flag set (-o wide).
-func buildPodRow(runtimeClient *podman.PodmanClient, appName string, pod runtime.Pod, pInfo *types.PodInspectReport) []string {
-	status := getPodStatus(runtimeClient, pInfo)
+// buildPodInfo - processes the pod to get the required info.
+// The exposed ports and container names are fetched only for the wide and structured outputs.
+func buildPodInfo(ctx context.Context, runtimeClient *podman.PodmanClient, appName string, pod runtime.Pod, pInfo *types.PodInspectReport) podInfo {
+	info := podInfo{
+		Host:        runtimeClient.Host,
+		Application: appName,
+		PodID:       pod.ID,
+		PodName:     pod.Name,
+		Status:      getPodStatus(ctx, runtimeClient, pInfo),
+		Created:     pInfo.Created,
+	}
 
-	// if wide option flag is not set, then return appName, podName and status only
-	if !isOutputWide() {
-		return []string{appName, pod.Name, status}
+	if !isOutputWide() && !psOutput.IsStructured() {
+		return info
 	}
 
 	podPorts, err := getPodPorts(pInfo)
 	if err != nil {
 		podPorts = []string{"none"}
 	}
+	info.Exposed = podPorts
+	info.Containers = getContainerNames(ctx, runtimeClient, pod)
 
-	containerNames := getContainerNames(runtimeClient, pod)
+	return info
+}
 
-	return []string{
-		appName,
-		pod.ID[:12],
-		pod.Name,
-		status,
-		utils.TimeAgo(pInfo.Created),
-		strings.Join(podPorts, ", "),
-		strings.Join(containerNames, ", "),
+// buildPodRow - builds the row using the pod info based on the wide options flag set (-o wide).
+func buildPodRow(info podInfo) []string {
+	var row []string
+	// if wide option flag is not set, then return appName, podName and status only
+	if !isOutputWide() {
+		row = []string{info.Application, info.PodName, info.Status}
+	} else {
+		row = []string{
+			info.Application,
+			info.PodID[:12],
+			info.PodName,
+			info.Status,
+			utils.TimeAgo(info.Created),
+			strings.Join(info.Exposed, ", "),
+			strings.Join(info.Containers, ", "),
+		}
 	}
+
+	// prepend the host column when listing across all the connections
+	if allConnections {
+		row = append([]string{info.Host}, row...)
+	}
+
+	return row
 }
 
 func fetchPodNameFromLabels(labels map[string]string) string {
@@ -194,11 +306,11 @@ func getPodPorts(pInfo *types.PodInspectReport) ([]string, error) {
 	return podPorts, nil
 }
 
-func getContainerNames(runtimeClient *podman.PodmanClient, pod runtime.Pod) []string {
+func getContainerNames(ctx context.Context, runtimeClient *podman.PodmanClient, pod runtime.Pod) []string {
 	containerNames := []string{}
 
 	for _, container := range pod.Containers {
-		cInfo, err := runtimeClient.InspectContainer(container.ID)
+		cInfo, err := runtimeClient.InspectContainer(ctx, container.ID)
 		if err != nil {
 			// skip container if inspect failed
 			logger.Infof("failed to do container inspect for pod: '%s', containerID: '%s' with error: %v", pod.Name, container.ID, err, logger.VerbosityLevelDebug)
@@ -220,12 +332,12 @@ func getContainerNames(runtimeClient *podman.PodmanClient, pod runtime.Pod) []st
 	return containerNames
 }
 
-func getPodStatus(runtimeClient *podman.PodmanClient, pInfo *types.PodInspectReport) string {
+func getPodStatus(ctx context.Context, runtimeClient *podman.PodmanClient, pInfo *types.PodInspectReport) string {
 	// if the pod Status is running, make sure to check if its healthy or not, otherwise fallback to default pod state
 	if pInfo.State == "Running" {
 		healthyContainers := 0
 		for _, container := range pInfo.Containers {
-			cInfo, err := runtimeClient.InspectContainer(container.ID)
+			cInfo, err := runtimeClient.InspectContainer(ctx, container.ID)
 			if err != nil {
 				// skip container if inspect failed
 				logger.Infof("failed to do container inspect for pod: '%s', containerID: '%s' with error: %v", pInfo.Name, container.ID, err, logger.VerbosityLevelDebug)
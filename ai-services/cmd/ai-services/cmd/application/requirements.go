@@ -0,0 +1,140 @@
+package application
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+
+	v1 "github.com/containers/podman/v5/pkg/k8s.io/api/core/v1"
+	"github.com/containers/podman/v5/pkg/k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// resolveHostRequirements returns the host requirements of the pods yet to be deployed.
+// The requirements declared in the template metadata take precedence over the ones derived from the rendered pod specs.
+func resolveHostRequirements(appMetadata *templates.AppMetadata, podSpecs []*models.PodSpec, appName string) (validators.HostRequirements, error) {
+	declared := appMetadata.Requirements
+	if declared == nil {
+		declared = &templates.Requirements{}
+	}
+
+	reqs := validators.HostRequirements{
+		KernelModules: declared.KernelModules,
+		PodmanVersion: declared.PodmanVersion,
+	}
+
+	var err error
+	if reqs.Memory, err = parseSize("memory", declared.Memory); err != nil {
+		return reqs, err
+	}
+	if reqs.Memory == 0 {
+		reqs.Memory = derivePodsMemory(podSpecs)
+	}
+
+	if reqs.AppDiskSpace, err = parseSize("appDiskSpace", declared.AppDiskSpace); err != nil {
+		return reqs, err
+	}
+
+	// space is required under the model directory only for the models yet to be downloaded
+	if !skipModelDownload {
+		if reqs.ModelDiskSpace, err = parseSize("modelDiskSpace", declared.ModelDiskSpace); err != nil {
+			return reqs, err
+		}
+		reqs.ModelDiskSpace = subtractDownloadedModels(reqs.ModelDiskSpace, appName)
+	}
+
+	reqs.Ports = slices.Clone(declared.Ports)
+	for _, podSpec := range podSpecs {
+		for _, hostPort := range fetchHostPortMappingFromAnnotation(fetchPodAnnotations(podSpec)) {
+			// host port is assigned dynamically if not set
+			port, err := strconv.Atoi(hostPort)
+			if err != nil {
+				continue
+			}
+			reqs.Ports = append(reqs.Ports, port)
+		}
+	}
+	slices.Sort(reqs.Ports)
+	reqs.Ports = slices.Compact(reqs.Ports)
+
+	return reqs, nil
+}
+
+// parseSize parses the resource quantity of the requirement into bytes. Returns 0 if the value is empty.
+func parseSize(name, value string) (uint64, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	q, err := resource.ParseQuantity(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid requirement %s '%s' in the application metadata: %w", name, value, err)
+	}
+
+	return quantityBytes(q), nil
+}
+
+// derivePodsMemory returns the memory required by the pods, i.e. the memory limits (or else the requests) of the
+// containers along with the memory backed emptyDir volumes.
+func derivePodsMemory(podSpecs []*models.PodSpec) uint64 {
+	var total uint64
+	for _, podSpec := range podSpecs {
+		for _, container := range podSpec.Spec.Containers {
+			if q, ok := container.Resources.Limits[v1.ResourceMemory]; ok {
+				total += quantityBytes(q)
+			} else if q, ok := container.Resources.Requests[v1.ResourceMemory]; ok {
+				total += quantityBytes(q)
+			}
+		}
+
+		for _, volume := range podSpec.Spec.Volumes {
+			if volume.EmptyDir != nil && volume.EmptyDir.Medium == v1.StorageMediumMemory && volume.EmptyDir.SizeLimit != nil {
+				total += quantityBytes(*volume.EmptyDir.SizeLimit)
+			}
+		}
+	}
+
+	return total
+}
+
+// subtractDownloadedModels reduces the required model disk space by the size of the template models already downloaded.
+func subtractDownloadedModels(required uint64, appName string) uint64 {
+	if required == 0 {
+		return 0
+	}
+
+	modelList, err := helpers.ListModelsWithValues(templateName, appName, values)
+	if err != nil {
+		logger.Infof("failed to list models, skipping downloaded models size: %v", err, logger.VerbosityLevelDebug)
+
+		return required
+	}
+
+	var downloaded uint64
+	for _, model := range modelList {
+		downloaded += utils.DirSize(filepath.Join(vars.ModelDirectory, model.Repo))
+	}
+
+	if downloaded >= required {
+		return 0
+	}
+
+	return required - downloaded
+}
+
+func quantityBytes(q resource.Quantity) uint64 {
+	value := q.Value()
+	if value <= 0 {
+		return 0
+	}
+
+	return uint64(value)
+}
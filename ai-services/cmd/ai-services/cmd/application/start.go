@@ -1,6 +1,7 @@
 package application
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -49,7 +50,7 @@ Note: Logs are streamed only when a single pod is specified, and only after the
 			return fmt.Errorf("failed to connect to podman: %w", err)
 		}
 
-		return startApplication(runtimeClient, applicationName, startPodNames)
+		return startApplication(cmd.Context(), runtimeClient, applicationName, startPodNames)
 	},
 }
 
@@ -60,8 +61,8 @@ func init() {
 }
 
 // startApplication starts all pods associated with the given application name.
-func startApplication(client *podman.PodmanClient, appName string, podNames []string) error {
-	pods, err := fetchPodsFromRuntime(client, appName)
+func startApplication(ctx context.Context, client *podman.PodmanClient, appName string, podNames []string) error {
+	pods, err := fetchPodsFromRuntime(ctx, client, appName)
 	if err != nil {
 		return err
 	}
@@ -79,7 +80,7 @@ func startApplication(client *podman.PodmanClient, appName string, podNames []st
 	*/
 
 	// Do Step 1, Step 2 and Step 3
-	podsToStart, err := fetchPodsToStart(client, pods, podNames)
+	podsToStart, err := fetchPodsToStart(ctx, client, pods, podNames)
 	if err != nil {
 		return err
 	}
@@ -89,14 +90,14 @@ func startApplication(client *podman.PodmanClient, appName string, podNames []st
 		return nil
 	}
 
-	if err := confirmAndStartPods(client, podsToStart); err != nil {
+	if err := confirmAndStartPods(ctx, client, podsToStart); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func confirmAndStartPods(client *podman.PodmanClient, podsToStart []runtime.Pod) error {
+func confirmAndStartPods(ctx context.Context, client *podman.PodmanClient, podsToStart []runtime.Pod) error {
 	logPodsToStart(podsToStart)
 	printLogs := shouldPrintLogs(podsToStart)
 
@@ -114,12 +115,12 @@ func confirmAndStartPods(client *podman.PodmanClient, podsToStart []runtime.Pod)
 
 	logger.Infoln("Proceeding to start pods...")
 
-	if err := startPods(client, podsToStart); err != nil {
+	if err := startPods(ctx, client, podsToStart); err != nil {
 		return err
 	}
 
 	if printLogs {
-		if err := printPodLogs(client, podsToStart); err != nil {
+		if err := printPodLogs(ctx, client, podsToStart); err != nil {
 			return err
 		}
 	}
@@ -146,8 +147,8 @@ func shouldPrintLogs(podsToStart []runtime.Pod) bool {
 	return true
 }
 
-func fetchPodsFromRuntime(client *podman.PodmanClient, appName string) ([]runtime.Pod, error) {
-	pods, err := client.ListPods(map[string][]string{
+func fetchPodsFromRuntime(ctx context.Context, client *podman.PodmanClient, appName string) ([]runtime.Pod, error) {
+	pods, err := client.ListPods(ctx, map[string][]string{
 		"label": {fmt.Sprintf("ai-services.io/application=%s", appName)},
 	})
 	if err != nil {
@@ -157,20 +158,20 @@ func fetchPodsFromRuntime(client *podman.PodmanClient, appName string) ([]runtim
 	return pods, err
 }
 
-func fetchPodsToStart(client *podman.PodmanClient, pods []runtime.Pod, podNames []string) ([]runtime.Pod, error) {
+func fetchPodsToStart(ctx context.Context, client *podman.PodmanClient, pods []runtime.Pod, podNames []string) ([]runtime.Pod, error) {
 	if len(podNames) > 0 {
 		return filterPodsByName(pods, podNames)
 	}
 
 	// No pod names provided, start pods based on annotation
-	return filterPodsByAnnotation(client, pods)
+	return filterPodsByAnnotation(ctx, client, pods)
 }
 
-func startPods(client *podman.PodmanClient, podsToStart []runtime.Pod) error {
+func startPods(ctx context.Context, client *podman.PodmanClient, podsToStart []runtime.Pod) error {
 	var errors []string
 	for _, pod := range podsToStart {
 		logger.Infof("Starting the pod: %s\n", pod.Name)
-		podData, err := client.InspectPod(pod.Name)
+		podData, err := client.InspectPod(ctx, pod.Name)
 		if err != nil {
 			errMsg := fmt.Sprintf("%s: %v", pod.Name, err)
 			errors = append(errors, errMsg)
@@ -183,7 +184,7 @@ func startPods(client *podman.PodmanClient, podsToStart []runtime.Pod) error {
 
 			continue
 		}
-		if err := client.StartPod(pod.ID); err != nil {
+		if err := client.StartPod(ctx, pod.ID); err != nil {
 			errMsg := fmt.Sprintf("%s: %v", pod.Name, err)
 			errors = append(errors, errMsg)
 
@@ -200,10 +201,10 @@ func startPods(client *podman.PodmanClient, podsToStart []runtime.Pod) error {
 	return nil
 }
 
-func printPodLogs(client *podman.PodmanClient, podsToStart []runtime.Pod) error {
+func printPodLogs(ctx context.Context, client *podman.PodmanClient, podsToStart []runtime.Pod) error {
 	logger.Infof("\n--- Following logs for pod: %s ---\n", podsToStart[0].Name)
 
-	if err := client.PodLogs(podsToStart[0].Name); err != nil {
+	if err := client.PodLogs(ctx, podsToStart[0].Name); err != nil {
 		// Check if error is due to interrupt signal (Ctrl+C)
 		if strings.Contains(err.Error(), "signal: interrupt") || strings.Contains(err.Error(), "context canceled") {
 			logger.Infoln("Log following stopped.")
@@ -243,14 +244,14 @@ func filterPodsByName(pods []runtime.Pod, podNames []string) ([]runtime.Pod, err
 	return podsToStart, nil
 }
 
-func filterPodsByAnnotation(client *podman.PodmanClient, pods []runtime.Pod) ([]runtime.Pod, error) {
+func filterPodsByAnnotation(ctx context.Context, client *podman.PodmanClient, pods []runtime.Pod) ([]runtime.Pod, error) {
 	var podsToStart []runtime.Pod
 
 outerloop:
 	for _, pod := range pods {
 		for _, container := range pod.Containers {
 			// inspect one of containers to get pod annotations
-			data, err := client.InspectContainer(container.Name)
+			data, err := client.InspectContainer(ctx, container.Name)
 			if err != nil {
 				return podsToStart, fmt.Errorf("failed to inspect container %s: %w", container.Name, err)
 			}
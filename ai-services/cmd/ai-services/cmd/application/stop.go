@@ -1,6 +1,7 @@
 package application
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -44,7 +45,7 @@ Arguments
 			return fmt.Errorf("failed to connect to podman: %w", err)
 		}
 
-		return stopApplication(runtimeClient, applicationName, stopPodNames)
+		return stopApplication(cmd.Context(), runtimeClient, applicationName, stopPodNames)
 	},
 }
 
@@ -54,8 +55,8 @@ func init() {
 }
 
 // stopApplication stops all pods associated with the given application name.
-func stopApplication(client *podman.PodmanClient, appName string, podNames []string) error {
-	pods, err := client.ListPods(map[string][]string{
+func stopApplication(ctx context.Context, client *podman.PodmanClient, appName string, podNames []string) error {
+	pods, err := client.ListPods(ctx, map[string][]string{
 		"label": {fmt.Sprintf("ai-services.io/application=%s", appName)},
 	})
 	if err != nil {
@@ -108,7 +109,7 @@ func stopApplication(client *podman.PodmanClient, appName string, podNames []str
 	logger.Infof("Proceeding to stop pods...\n")
 
 	// 3. Proceed to stop only the valid pods
-	return stopPods(client, podsToStop)
+	return stopPods(ctx, client, podsToStop)
 }
 
 func fetchPodsToStop(pods []runtime.Pod, podNames []string, appName string) ([]runtime.Pod, error) {
@@ -142,12 +143,12 @@ func fetchPodsToStop(pods []runtime.Pod, podNames []string, appName string) ([]r
 	return podsToStop, nil
 }
 
-func stopPods(client *podman.PodmanClient, podsToStop []runtime.Pod) error {
+func stopPods(ctx context.Context, client *podman.PodmanClient, podsToStop []runtime.Pod) error {
 	var errors []string
 	for _, pod := range podsToStop {
 		logger.Infof("Stopping the pod: %s\n", pod.Name)
 
-		if err := client.StopPod(pod.ID); err != nil {
+		if err := client.StopPod(ctx, pod.ID); err != nil {
 			errMsg := fmt.Sprintf("%s: %v", pod.Name, err)
 			errors = append(errors, errMsg)
 
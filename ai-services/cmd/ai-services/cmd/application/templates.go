@@ -8,54 +8,52 @@ import (
 
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/output"
 )
 
+var templatesOutput output.Options
+
+// templateInfo is the machine-readable representation of an application template.
+type templateInfo struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Parameters  map[string]string `json:"parameters"`
+}
+
 var templatesCmd = &cobra.Command{
 	Use:   "templates",
 	Short: "Lists the offered application templates and their supported parameters",
 	Long:  `Retrieves information about the offered application templates and their supported parameters`,
 	PreRunE: func(cmd *cobra.Command, args []string) error {
-		// Once precheck passes, silence usage for any *later* internal errors.
-		cmd.SilenceUsage = true
-
-		return nil
+		return templatesOutput.Validate()
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
 
-		tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
-
-		appTemplateNames, err := tp.ListApplications(hiddenTemplates)
+		tmplInfos, err := fetchTemplateInfos()
 		if err != nil {
-			return fmt.Errorf("failed to list application templates: %w", err)
+			return err
+		}
+
+		if templatesOutput.IsStructured() {
+			return templatesOutput.Print("ApplicationTemplateList", tmplInfos)
 		}
 
-		if len(appTemplateNames) == 0 {
+		if len(tmplInfos) == 0 {
 			logger.Infoln("No application templates found.")
 
 			return nil
 		}
 
-		// sort appTemplateNames alphabetically
-		sort.Strings(appTemplateNames)
-
 		logger.Infoln("Available application templates:")
-		for _, name := range appTemplateNames {
-			appTemplatesParametersWithDescription, err := tp.ListApplicationTemplateValues(name)
-			if err != nil {
-				return fmt.Errorf("failed to list application template values: %w", err)
-			}
-			logger.Infof("- %s\n", name)
-			metadata, err := tp.LoadMetadata(name)
-			if err != nil {
-				return fmt.Errorf("failed to load application metadata: %w", err)
-			}
-			if metadata.Description != "" {
-				logger.Infof("  Description: %s", metadata.Description)
+		for _, tmplInfo := range tmplInfos {
+			logger.Infof("- %s\n", tmplInfo.Name)
+			if tmplInfo.Description != "" {
+				logger.Infof("  Description: %s", tmplInfo.Description)
 			}
 			logger.Infoln("\n  Supported Parameters:")
-			for k, v := range appTemplatesParametersWithDescription {
+			for k, v := range tmplInfo.Parameters {
 				logger.Infoln("\t" + k + ":  " + v)
 			}
 		}
@@ -63,3 +61,39 @@ var templatesCmd = &cobra.Command{
 		return nil
 	},
 }
+
+func init() {
+	output.AddFlags(templatesCmd, &templatesOutput)
+}
+
+// fetchTemplateInfos returns the offered application templates sorted alphabetically.
+func fetchTemplateInfos() ([]templateInfo, error) {
+	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+
+	appTemplateNames, err := tp.ListApplications(hiddenTemplates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list application templates: %w", err)
+	}
+
+	// sort appTemplateNames alphabetically
+	sort.Strings(appTemplateNames)
+
+	tmplInfos := make([]templateInfo, 0, len(appTemplateNames))
+	for _, name := range appTemplateNames {
+		appTemplatesParametersWithDescription, err := tp.ListApplicationTemplateValues(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list application template values: %w", err)
+		}
+		metadata, err := tp.LoadMetadata(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load application metadata: %w", err)
+		}
+		tmplInfos = append(tmplInfos, templateInfo{
+			Name:        name,
+			Description: metadata.Description,
+			Parameters:  appTemplatesParametersWithDescription,
+		})
+	}
+
+	return tmplInfos, nil
+}
@@ -0,0 +1,279 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/output"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+const (
+	// clearScreen moves the cursor to the top left and clears the terminal before each refresh.
+	clearScreen = "\033[H\033[2J"
+	// sizePrecision is the number of significant digits used while printing the sizes.
+	sizePrecision = 3
+)
+
+var (
+	topOutput   output.Options
+	topNoStream bool
+)
+
+// containerUsage holds the resource usage of an application container along with the Spyre cards it holds.
+type containerUsage struct {
+	Application string `json:"application"`
+	Pod         string `json:"pod"`
+	runtime.ContainerStats
+	SpyrePCIAddresses []string `json:"spyrePCIAddresses"`
+}
+
+// containerMeta holds the application details of a running container.
+type containerMeta struct {
+	application       string
+	pod               string
+	spyrePCIAddresses []string
+}
+
+var topCmd = &cobra.Command{
+	Use:   "top [name]",
+	Short: "Display the live resource usage of the application containers",
+	Long: `Streams the CPU, memory, network and block IO usage of the running application containers
+along with the Spyre cards (PCI addresses) held by each container.
+Displays the usage of all the applications if no name is provided.
+
+Arguments
+  [name]: Application name (optional)
+`,
+	Example: `  # Stream the resource usage of the rag application
+  ai-services application top rag
+
+  # Print the resource usage once in json format
+  ai-services application top rag --no-stream -o json`,
+	Args: cobra.MaximumNArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := topOutput.Validate(); err != nil {
+			return err
+		}
+
+		if len(args) > 0 {
+			return utils.VerifyAppName(args[0])
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var applicationName string
+		if len(args) > 0 {
+			applicationName = args[0]
+		}
+
+		// Once precheck passes, silence usage for any *later* internal errors.
+		cmd.SilenceUsage = true
+
+		runtimeClient, err := podman.NewPodmanClient()
+		if err != nil {
+			return fmt.Errorf("failed to connect to podman: %w", err)
+		}
+
+		// stop streaming on Ctrl+C
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		if err := runTopCmd(ctx, runtimeClient, applicationName); err != nil {
+			return fmt.Errorf("failed to fetch application resource usage: %w", err)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	output.AddFlags(topCmd, &topOutput)
+	topCmd.Flags().BoolVar(&topNoStream, "no-stream", false, "Display the resource usage once instead of streaming it")
+}
+
+func runTopCmd(ctx context.Context, client *podman.PodmanClient, appName string) error {
+	containers, err := fetchRunningAppContainers(ctx, client, appName)
+	if err != nil {
+		return err
+	}
+
+	if len(containers) == 0 {
+		if topOutput.IsStructured() {
+			return topOutput.Print("ContainerUsageList", []containerUsage{})
+		}
+
+		if appName != "" {
+			logger.Infof("No running containers found for the given application name: %s", appName)
+		} else {
+			logger.Infoln("No running application containers found")
+		}
+
+		return nil
+	}
+
+	statsChan, err := client.Stats(ctx, utils.ExtractMapKeys(containers), !topNoStream)
+	if err != nil {
+		return fmt.Errorf("failed to fetch container stats: %w", err)
+	}
+
+	for report := range statsChan {
+		if report.Error != nil {
+			// streaming is stopped on Ctrl+C
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("failed to fetch container stats: %w", report.Error)
+		}
+
+		if err := renderUsage(buildContainerUsage(containers, report.Stats)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fetchRunningAppContainers returns the running application containers (excluding the infra containers).
+// Returns: Key -> containerID, Value -> application details of the container.
+func fetchRunningAppContainers(ctx context.Context, client *podman.PodmanClient, appName string) (map[string]containerMeta, error) {
+	pods, err := fetchFilteredPods(ctx, client, appName)
+	if err != nil {
+		return nil, err
+	}
+
+	containers := map[string]containerMeta{}
+	for _, pod := range pods {
+		podAppName := fetchPodNameFromLabels(pod.Labels)
+		if podAppName == "" {
+			// skip pods which are not linked to ai-services
+			continue
+		}
+
+		pInfo, err := client.InspectPod(ctx, pod.ID)
+		if err != nil {
+			// log and skip pod if inspect failed
+			logger.Errorf("Failed to do pod inspect: '%s' with error: %v", pod.ID, err)
+
+			continue
+		}
+
+		for _, container := range pInfo.Containers {
+			if container.ID == pInfo.InfraContainerID {
+				continue
+			}
+
+			cInfo, err := client.InspectContainer(ctx, container.ID)
+			if err != nil {
+				// skip container if inspect failed
+				logger.Infof("failed to do container inspect for pod: '%s', containerID: '%s' with error: %v", pod.Name, container.ID, err, logger.VerbosityLevelDebug)
+
+				continue
+			}
+
+			// stats are only available for the running containers
+			if !cInfo.State.Running {
+				continue
+			}
+
+			containers[container.ID] = containerMeta{
+				application:       podAppName,
+				pod:               pod.Name,
+				spyrePCIAddresses: fetchSpyrePCIAddresses(cInfo.Config.Env),
+			}
+		}
+	}
+
+	return containers, nil
+}
+
+// fetchSpyrePCIAddresses returns the Spyre PCI addresses assigned to the container via its env.
+func fetchSpyrePCIAddresses(env []string) []string {
+	for _, e := range env {
+		if val, ok := strings.CutPrefix(e, string(constants.PCIAddressKey)+"="); ok {
+			return strings.Fields(val)
+		}
+	}
+
+	return []string{}
+}
+
+func buildContainerUsage(containers map[string]containerMeta, stats []runtime.ContainerStats) []containerUsage {
+	usage := make([]containerUsage, 0, len(stats))
+	for _, s := range stats {
+		meta := containers[s.ID]
+		usage = append(usage, containerUsage{
+			Application:       meta.application,
+			Pod:               meta.pod,
+			ContainerStats:    s,
+			SpyrePCIAddresses: meta.spyrePCIAddresses,
+		})
+	}
+
+	// sort by application and container name to keep the rows stable across refreshes
+	slices.SortFunc(usage, func(a, b containerUsage) int {
+		if c := strings.Compare(a.Application, b.Application); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return usage
+}
+
+// renderUsage renders the resource usage. When streaming, a document is emitted for each refresh in the structured outputs.
+func renderUsage(usage []containerUsage) error {
+	if topOutput.IsStructured() {
+		return topOutput.Print("ContainerUsageList", usage)
+	}
+
+	// the escape sequences and the table are written to the same stream to not leave one without the other when
+	// redirected
+	if !topNoStream {
+		fmt.Fprint(os.Stdout, clearScreen)
+	}
+
+	p := utils.NewTableWriter()
+	defer p.Render(os.Stdout)
+
+	p.SetHeaders("APPLICATION NAME", "CONTAINER", "CPU %", "MEM USAGE / LIMIT", "MEM %", "NET IO", "BLOCK IO", "PIDS", "SPYRE")
+	for _, u := range usage {
+		spyre := "none"
+		if len(u.SpyrePCIAddresses) > 0 {
+			spyre = strings.Join(u.SpyrePCIAddresses, ", ")
+		}
+
+		p.AppendRow(
+			u.Application,
+			u.Name,
+			fmt.Sprintf("%.2f%%", u.CPUPercent),
+			fmt.Sprintf("%s / %s", humanSize(u.MemUsage), humanSize(u.MemLimit)),
+			fmt.Sprintf("%.2f%%", u.MemPercent),
+			fmt.Sprintf("%s / %s", humanSize(u.NetInput), humanSize(u.NetOutput)),
+			fmt.Sprintf("%s / %s", humanSize(u.BlockInput), humanSize(u.BlockOutput)),
+			fmt.Sprintf("%d", u.PIDs),
+			spyre,
+		)
+	}
+
+	return nil
+}
+
+func humanSize(size uint64) string {
+	return units.HumanSizeWithPrecision(float64(size), sizePrecision)
+}
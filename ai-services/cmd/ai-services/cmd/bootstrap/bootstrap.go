@@ -4,7 +4,9 @@ import (
 	"fmt"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators/root"
 	"github.com/spf13/cobra"
 )
@@ -31,7 +33,11 @@ Available subcommands:
   • RHEL OS
   • LPAR affinity
   • Spyre cards availability
-  • ServiceReport validation`,
+  • ServiceReport validation
+
+  reset - Reverts the changes made by configure
+  • Refuses while applications exist unless --force is set
+  • Reports the changes which could not be reverted`,
 		Example: `  # Validate the environment
   ai-services bootstrap validate
 
@@ -41,16 +47,16 @@ Available subcommands:
   # Get help on a specific subcommand
   ai-services bootstrap validate --help`,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
-			return root.NewRootRule().Verify()
+			return root.NewRootRule(host.Local()).Verify(cmd.Context())
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			logger.Infof("Configuring the LPAR")
-			if configureErr := RunConfigureCmd(); configureErr != nil {
+			if configureErr := RunConfigureCmd(cmd.Context(), nil); configureErr != nil {
 				return fmt.Errorf("failed to bootstrap the LPAR: %w", configureErr)
 			}
 
 			logger.Infof("Validating LPAR")
-			if validateErr := RunValidateCmd(nil); validateErr != nil {
+			if _, validateErr := RunValidateCmd(cmd.Context(), nil, validators.DefaultProfile()); validateErr != nil {
 				return fmt.Errorf("failed to bootstrap the LPAR: %w", validateErr)
 			}
 
@@ -67,6 +73,7 @@ Available subcommands:
 	// subcommands
 	bootstrapCmd.AddCommand(validateCmd())
 	bootstrapCmd.AddCommand(configureCmd())
+	bootstrapCmd.AddCommand(resetCmd())
 
 	return bootstrapCmd
 }
@@ -3,39 +3,55 @@ package bootstrap
 import (
 	"context"
 	"fmt"
-	"os/exec"
-	"strconv"
-	"strings"
-	"time"
 
-	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/bundle"
+	"github.com/project-ai-services/ai-services/internal/pkg/configure"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
-	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
-	"github.com/project-ai-services/ai-services/internal/pkg/validators"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators/root"
-	"github.com/project-ai-services/ai-services/internal/pkg/validators/spyre"
 	"github.com/spf13/cobra"
 )
 
-const (
-	podmanSocketWaitDuration = 2 * time.Second
-	contextTimeout           = 30 * time.Second
-)
-
-// configureCmd represents the validate subcommand of bootstrap.
+// configureCmd represents the configure subcommand of bootstrap.
 func configureCmd() *cobra.Command {
+	var (
+		dryRun     bool
+		bundlePath string
+	)
+
 	cmd := &cobra.Command{
-		Use:    "configure",
-		Short:  "Configures the LPAR environment",
-		Long:   `Configure and initialize the LPAR.`,
+		Use:   "configure",
+		Short: "Configures the LPAR environment",
+		Long: `Configure and initialize the LPAR.
+
+Each configuration step is checked first and applied only if the LPAR is not configured by it yet.
+The applied changes are recorded in the journal at ` + constants.ConfigureJournalPath + `.`,
+		Example: `  # Preview the packages, files, modules, groups and services which would change
+  ai-services bootstrap configure --dry-run
+
+  # Configure an air-gapped LPAR using a bundle created by 'ai-services bundle create'
+  ai-services bootstrap configure --bundle ai-services-rag-bundle.tar`,
 		Hidden: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Once precheck passes, silence usage for any *later* internal errors.
 			cmd.SilenceUsage = true
 
+			var b *bundle.Bundle
+			if bundlePath != "" {
+				var err error
+				if b, err = bundle.Open(bundlePath); err != nil {
+					return err
+				}
+			}
+
+			if dryRun {
+				return RunConfigurePlan(cmd.Context(), b)
+			}
+
 			logger.Infoln("Running bootstrap configuration...")
 
-			err := RunConfigureCmd()
+			err := RunConfigureCmd(cmd.Context(), b)
 			if err != nil {
 				return fmt.Errorf("bootstrap configuration failed: %w", err)
 			}
@@ -46,200 +62,45 @@ func configureCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Print the changes the configuration would make on the LPAR without applying them")
+	cmd.Flags().StringVar(&bundlePath, "bundle", "", "Install the packages and the tool image from the bundle, without reaching the internet")
+
 	return cmd
 }
 
-func RunConfigureCmd() error {
-	rootCheck := root.NewRootRule()
-	if err := rootCheck.Verify(); err != nil {
+// RunConfigurePlan prints the changes the configuration steps would make on the LPAR, installing from the bundle if set.
+func RunConfigurePlan(ctx context.Context, b *bundle.Bundle) error {
+	h := host.Local()
+	if err := root.NewRootRule(h).Verify(ctx); err != nil {
 		return err
 	}
-	ctx := context.Background()
-
-	s := spinner.New("Checking podman installation")
-	s.Start(ctx)
-	// 1. Install and configure Podman if not done
-	// 1.1 Install Podman
-	if _, err := validators.Podman(); err != nil {
-		s.UpdateMessage("Installing podman")
-		// setup podman socket and enable service
-		if err := installPodman(); err != nil {
-			s.Fail("failed to install podman")
-
-			return err
-		}
-		s.Stop("podman installed successfully")
-	} else {
-		s.Stop("podman already installed")
-	}
 
-	s = spinner.New("Verifying podman configuration")
-	s.Start(ctx)
-	// 1.2 Configure Podman
-	if err := validators.PodmanHealthCheck(); err != nil {
-		s.UpdateMessage("Configuring podman")
-		if err := setupPodman(); err != nil {
-			s.Fail("failed to configure podman")
-
-			return err
-		}
-		s.Stop("podman configured successfully")
-	} else {
-		s.Stop("Podman already configured")
-	}
-
-	s = spinner.New("Checking spyre card configuration")
-	s.Start(ctx)
-	// 2. Spyre cards – run servicereport tool to validate and repair spyre configurations
-	if err := runServiceReport(); err != nil {
-		s.Fail("failed to configure spyre card")
-
-		return err
+	plans, err := configure.Plan(ctx, configure.Steps(h, b))
+	// print the steps planned before the failure as well
+	configure.PrintPlan(plans)
+	if err != nil {
+		return fmt.Errorf("failed to plan the bootstrap configuration: %w", err)
 	}
-	s.Stop("Spyre cards configuration validated successfully.")
-
-	logger.Infoln("LPAR configured successfully")
 
 	return nil
 }
 
-func runServiceReport() error {
-	// validate spyre attachment first before running servicereport
-	spyreCheck := spyre.NewSpyreRule()
-	err := spyreCheck.Verify()
-	if err != nil {
+// RunConfigureCmd applies the configuration steps on the LPAR and records the changes in the journal.
+// The packages and the tool image are installed from the bundle if set.
+func RunConfigureCmd(ctx context.Context, b *bundle.Bundle) error {
+	h := host.Local()
+	rootCheck := root.NewRootRule(h)
+	if err := rootCheck.Verify(ctx); err != nil {
 		return err
 	}
 
-	// Create host directories for vfio
-	cmd := `mkdir -p /etc/modules-load.d; mkdir -p /etc/udev/rules.d/`
-	_, err = exec.Command("bash", "-c", cmd).Output()
-	if err != nil {
-		return fmt.Errorf("❌ failed to create host volume mounts for servicereport tool %w", err)
-	}
-
-	// load vfio kernel modules
-	cmd = `modprobe vfio_pci`
-	_, err = exec.Command("bash", "-c", cmd).Output()
-	if err != nil {
-		return fmt.Errorf("❌ failed to load vfio kernel modules for spyre %w", err)
-	}
-	logger.Infoln("VFIO kernel modules loaded on the host", logger.VerbosityLevelDebug)
-
-	if err := helpers.RunServiceReportContainer("servicereport -r -p spyre", "configure"); err != nil {
-		return err
-	}
-
-	if err := configureUsergroup(); err != nil {
-		return err
-	}
-
-	if err := reloadUdevRules(); err != nil {
+	journal := configure.NewJournal(constants.ConfigureJournalPath)
+	if err := configure.Apply(ctx, configure.Steps(h, b), journal); err != nil {
 		return err
 	}
+	logger.Infof("Changes are recorded in the journal %s\n", journal.Path(), logger.VerbosityLevelDebug)
 
-	cards, err := helpers.ListSpyreCards()
-	if err != nil || len(cards) == 0 {
-		return fmt.Errorf("❌ failed to list spyre cards on LPAR %w", err)
-	}
-	num_spyre_cards := len(cards)
-
-	// check if kernel modules for vfio are loaded
-	if err := checkKernelModulesLoaded(num_spyre_cards); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func configureUsergroup() error {
-	cmd_str := `groupadd sentient; usermod -aG sentient $USER`
-	cmd := exec.Command("bash", "-c", cmd_str)
-	out, err := cmd.CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("failed to create sentient group and add current user to the sentient group. Error: %w, output: %s", err, string(out))
-	}
-
-	return nil
-}
-
-func reloadUdevRules() error {
-	cmd := `udevadm control --reload-rules`
-	_, err := exec.Command("bash", "-c", cmd).Output()
-	if err != nil {
-		return fmt.Errorf("failed to reload udev rules. Error: %w", err)
-	}
-
-	return nil
-}
-
-func checkKernelModulesLoaded(num_spyre_cards int) error {
-	vfio_cmd := `lspci -k -d 1014:06a7 | grep "Kernel driver in use: vfio-pci" | wc -l`
-	out, err := exec.Command("bash", "-c", vfio_cmd).Output()
-	if err != nil {
-		return fmt.Errorf("❌ failed to check vfio cards with kernel modules loaded %w", err)
-	}
-
-	num_vf_cards, err := strconv.Atoi(strings.TrimSuffix(string(out), "\n"))
-	if err != nil {
-		return fmt.Errorf("❌ failed to convert number of virtual spyre cards count from string to integer %w", err)
-	}
-
-	if num_vf_cards != num_spyre_cards {
-		logger.Infof("failed to detect vfio cards, reloading vfio kernel modules..")
-		// reload vfio kernel modules
-		cmd := `rmmod vfio_pci; modprobe vfio_pci`
-		_, err = exec.Command("bash", "-c", cmd).Output()
-		if err != nil {
-			return fmt.Errorf("❌ failed to reload vfio kernel modules for spyre %w", err)
-		}
-		logger.Infoln("VFIO kernel modules reloaded on the host", logger.VerbosityLevelDebug)
-	}
-
-	return nil
-}
-
-func installPodman() error {
-	cmd := exec.Command("dnf", "-y", "install", "podman")
-	out, err := cmd.CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("failed to install podman: %v, output: %s", err, string(out))
-	}
-
-	return nil
-}
-
-func setupPodman() error {
-	// start podman socket
-	if err := systemctl("start", "podman.socket"); err != nil {
-		return fmt.Errorf("failed to start podman socket: %w", err)
-	}
-	// enable podman socket
-	if err := systemctl("enable", "podman.socket"); err != nil {
-		return fmt.Errorf("failed to enable podman socket: %w", err)
-	}
-
-	logger.Infoln("Waiting for podman socket to be ready...", logger.VerbosityLevelDebug)
-	time.Sleep(podmanSocketWaitDuration) // wait for socket to be ready
-
-	if err := validators.PodmanHealthCheck(); err != nil {
-		return fmt.Errorf("podman health check failed after configuration: %w", err)
-	}
-
-	logger.Infof("Podman configured successfully.")
-
-	return nil
-}
-
-func systemctl(action, unit string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), contextTimeout)
-	defer cancel()
-
-	cmd := exec.CommandContext(ctx, "systemctl", action, unit)
-	out, err := cmd.CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("failed to %s %s: %v, output: %s", action, unit, err, string(out))
-	}
+	logger.Infoln("LPAR configured successfully")
 
 	return nil
 }
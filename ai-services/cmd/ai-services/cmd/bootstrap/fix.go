@@ -0,0 +1,145 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators"
+)
+
+// fixOutcome holds the status of a failed check before and after applying its fix.
+type fixOutcome struct {
+	name   string
+	before validators.Status
+	after  validators.Status
+	note   string
+}
+
+// hasIssues reports whether any of the checks failed or reported a warning.
+func hasIssues(report *validators.Report) bool {
+	return report.Count(validators.StatusFail)+report.Count(validators.StatusWarn) > 0
+}
+
+// runFixes runs the fixers of the failed checks, verifies them again and updates their results in the report.
+// Checks without a safe fix are only reported.
+func runFixes(ctx context.Context, report *validators.Report, profile *validators.Profile, autoYes, interactive bool) error {
+	rules := map[string]validators.Rule{}
+	for _, rule := range validators.DefaultRegistry.Rules() {
+		rules[rule.Name()] = rule
+	}
+
+	var (
+		outcomes []fixOutcome
+		fixable  []validators.Rule
+	)
+	for _, result := range report.Results {
+		if result.Status != validators.StatusFail && result.Status != validators.StatusWarn {
+			continue
+		}
+
+		fixer, ok := rules[result.Name].(validators.Fixer)
+		if !ok {
+			outcomes = append(outcomes, fixOutcome{name: result.Name, before: result.Status, after: result.Status, note: "no safe fix available"})
+
+			continue
+		}
+		if !fixer.CanFix() {
+			outcomes = append(outcomes, fixOutcome{name: result.Name, before: result.Status, after: result.Status, note: "fix cannot be applied on this host"})
+
+			continue
+		}
+		fixable = append(fixable, rules[result.Name])
+	}
+
+	applied, err := confirmFixes(fixable, autoYes)
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range fixable {
+		before := findStatus(report, rule.Name())
+		if !applied {
+			outcomes = append(outcomes, fixOutcome{name: rule.Name(), before: before, after: before, note: "fix not applied"})
+
+			continue
+		}
+		outcomes = append(outcomes, applyFix(ctx, report, rule, profile, before, interactive))
+	}
+
+	if interactive {
+		printFixOutcomes(outcomes)
+	}
+
+	return nil
+}
+
+// confirmFixes asks the user to confirm applying the fixes unless autoYes is set.
+func confirmFixes(fixable []validators.Rule, autoYes bool) (bool, error) {
+	if len(fixable) == 0 || autoYes {
+		return true, nil
+	}
+
+	names := make([]string, 0, len(fixable))
+	for _, rule := range fixable {
+		names = append(names, rule.Name())
+	}
+
+	return utils.ConfirmAction(fmt.Sprintf("Apply fixes for the failed checks: %s?", strings.Join(names, ", ")))
+}
+
+// applyFix runs the fix of the rule and verifies the rule again.
+func applyFix(ctx context.Context, report *validators.Report, rule validators.Rule, profile *validators.Profile, before validators.Status, interactive bool) fixOutcome {
+	var s *spinner.Spinner
+	if interactive {
+		s = spinner.New("Fixing " + rule.Name() + " ...")
+		s.Start(ctx)
+	}
+
+	outcome := fixOutcome{name: rule.Name(), before: before, after: before}
+	if err := rule.(validators.Fixer).Fix(ctx); err != nil {
+		outcome.note = err.Error()
+		if s != nil {
+			s.Fail("failed to fix " + rule.Name())
+		}
+		logger.Infof("failed to fix %s: %v", rule.Name(), err, logger.VerbosityLevelDebug)
+
+		return outcome
+	}
+	if s != nil {
+		s.Stop("Fix applied for " + rule.Name())
+	}
+
+	result := verifyRule(ctx, rule, profile.LevelOf(rule))
+	report.Update(result)
+	outcome.after = result.Status
+	if result.Status != validators.StatusPass {
+		outcome.note = result.Message
+	}
+
+	return outcome
+}
+
+func findStatus(report *validators.Report, name string) validators.Status {
+	for _, result := range report.Results {
+		if result.Name == name {
+			return result.Status
+		}
+	}
+
+	return ""
+}
+
+// printFixOutcomes prints the before/after status of each of the failed checks.
+func printFixOutcomes(outcomes []fixOutcome) {
+	p := utils.NewTableWriter()
+	defer p.CloseTableWriter()
+
+	p.SetHeaders("CHECK", "BEFORE", "AFTER", "NOTE")
+	for _, o := range outcomes {
+		p.AppendRow(o.name, string(o.before), string(o.after), o.note)
+	}
+}
@@ -0,0 +1,176 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/configure"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/smt"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/root"
+	"github.com/spf13/cobra"
+)
+
+type resetOptions struct {
+	keepPodman bool
+	autoYes    bool
+	force      bool
+}
+
+// resetCmd represents the reset subcommand of bootstrap.
+func resetCmd() *cobra.Command {
+	opts := &resetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "reset",
+		Short: "Reverts the LPAR configuration",
+		Long: `Reverts the changes made on the LPAR by bootstrap configure and application create, latest first.
+
+The changes are read from the journal at ` + constants.ConfigureJournalPath + `.
+The changes which could not be reverted are reported and kept in the journal to retry the reset.`,
+		Example: `  # Revert the LPAR configuration
+  ai-services bootstrap reset
+
+  # Revert the LPAR configuration keeping podman installed, without prompting
+  ai-services bootstrap reset --keep-podman --yes`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return root.NewRootRule(host.Local()).Verify(cmd.Context())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Once precheck passes, silence usage for any *later* internal errors.
+			cmd.SilenceUsage = true
+
+			return runReset(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.keepPodman, "keep-podman", false, "Keep podman installed along with the podman socket enabled")
+	cmd.Flags().BoolVarP(&opts.autoYes, "yes", "y", false, "Automatically accept all confirmation prompts (default=false)")
+	cmd.Flags().BoolVar(&opts.force, "force", false, "Reset even if applications exist on the LPAR")
+
+	return cmd
+}
+
+func runReset(ctx context.Context, opts *resetOptions) error {
+	if !opts.force {
+		apps, err := listApplications(ctx)
+		if err != nil {
+			return err
+		}
+		if len(apps) > 0 {
+			return fmt.Errorf("applications exist on the LPAR: %s; delete them first or use --force", strings.Join(apps, ", "))
+		}
+	}
+
+	journal := configure.NewJournal(constants.ConfigureJournalPath)
+	entries, err := journal.Entries()
+	if err != nil {
+		return err
+	}
+
+	smtState, err := smt.NewManager(host.Local()).State()
+	if err != nil {
+		return err
+	}
+
+	resetOpts := configure.ResetOptions{KeepPodman: opts.keepPodman}
+	pending := configure.PendingReverts(entries, resetOpts)
+	if len(pending) == 0 && smtState.Original == 0 {
+		logger.Infoln("No recorded changes to revert on the LPAR.")
+
+		return nil
+	}
+
+	printEntries(pending)
+	if smtState.Original != 0 {
+		logger.Infof("The SMT level will be restored to %d\n", smtState.Original)
+	}
+
+	if !opts.autoYes {
+		confirmed, err := utils.ConfirmAction("Revert the above changes on the LPAR?")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			logger.Infoln("Reset cancelled")
+
+			return nil
+		}
+	}
+
+	result, err := configure.Reset(ctx, host.Local(), journal, resetOpts)
+	if err != nil {
+		return fmt.Errorf("bootstrap reset failed: %w", err)
+	}
+
+	// the SMT level is restored even if applications are recorded as its users, as the reset is forced by then
+	smtErr := smt.NewManager(host.Local()).Restore(ctx, true)
+	if smtErr != nil {
+		logger.Warningf("failed to restore the SMT level: %v\n", smtErr)
+	}
+
+	logger.Infof("Reverted %d change(s) on the LPAR\n", len(result.Reverted))
+	if len(result.Failed) > 0 {
+		logger.Warningf("The below changes could not be reverted:\n")
+		printFailures(result.Failed)
+
+		return fmt.Errorf("%d change(s) could not be reverted", len(result.Failed))
+	}
+	if smtErr != nil {
+		return fmt.Errorf("failed to restore the SMT level: %w", smtErr)
+	}
+
+	logger.Infoln("LPAR reset successfully")
+
+	return nil
+}
+
+// listApplications returns the names of the applications with pods on the LPAR.
+// No applications are returned if podman is not reachable, as no pods can exist.
+func listApplications(ctx context.Context) ([]string, error) {
+	client, err := podman.NewPodmanClient()
+	if err != nil {
+		logger.Infof("podman is not reachable, assuming no applications exist: %v\n", err, logger.VerbosityLevelDebug)
+
+		return nil, nil
+	}
+
+	pods, err := client.ListPods(ctx, map[string][]string{"label": {constants.ApplicationAnnotationKey}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	var apps []string
+	for _, pod := range pods {
+		if app := pod.Labels[constants.ApplicationAnnotationKey]; app != "" && !slices.Contains(apps, app) {
+			apps = append(apps, app)
+		}
+	}
+	slices.Sort(apps)
+
+	return apps, nil
+}
+
+func printEntries(entries []configure.Entry) {
+	p := utils.NewTableWriter()
+	defer p.CloseTableWriter()
+	p.SetHeaders("STEP", "KIND", "TARGET", "REVERTS")
+	for _, entry := range entries {
+		p.AppendRow(entry.Step, entry.Change.Kind, entry.Change.Target, entry.Change.String())
+	}
+}
+
+func printFailures(failures []configure.Failure) {
+	p := utils.NewTableWriter()
+	defer p.CloseTableWriter()
+	p.SetHeaders("STEP", "KIND", "TARGET", "CHANGE", "REASON")
+	for _, failure := range failures {
+		p.AppendRow(failure.Entry.Step, failure.Entry.Change.Kind, failure.Entry.Change.Target, failure.Entry.Change.String(), failure.Reason)
+	}
+}
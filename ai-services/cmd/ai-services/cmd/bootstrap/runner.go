@@ -0,0 +1,161 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators"
+)
+
+// runRules verifies the rules concurrently, each rule starting once the rules it depends on have completed.
+// Rules depending on a failed rule are skipped. Returns the results in the order of the rules.
+// The levels of the rules are overridden by the profile. The progress of each rule is rendered if progress is set.
+func runRules(ctx context.Context, rules []validators.Rule, skip map[string]bool, profile *validators.Profile, progress *spinner.Progress) []validators.Result {
+	results := make([]validators.Result, len(rules))
+	index := make(map[string]int, len(rules))
+	done := make([]chan struct{}, len(rules))
+	for i, rule := range rules {
+		index[rule.Name()] = i
+		done[i] = make(chan struct{})
+	}
+
+	var wg sync.WaitGroup
+	for i, rule := range rules {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(done[i])
+
+			level := profile.LevelOf(rule)
+
+			// only the rules before this one are considered to avoid waiting on a dependency cycle
+			for _, dep := range validators.Dependencies(rule) {
+				j, ok := index[dep]
+				if !ok || j >= i {
+					continue
+				}
+				<-done[j]
+				if results[j].Status == validators.StatusFail {
+					results[i] = skippedResult(rule, level, fmt.Sprintf("%s check skipped as the %s check failed", rule.Name(), dep))
+					showResult(progress, results[i])
+
+					return
+				}
+			}
+
+			if skip[rule.Name()] {
+				results[i] = skippedResult(rule, level, rule.Name()+" check skipped; Proceeding without validation may result in deployment failure.")
+				if progress == nil {
+					logger.Warningln(results[i].Message)
+				}
+				showResult(progress, results[i])
+
+				return
+			}
+
+			if progress != nil {
+				progress.Update(rule.Name(), "Validating "+rule.Name()+" ...")
+			}
+			results[i] = verifyRule(ctx, rule, level)
+			showResult(progress, results[i])
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+// verifyRule runs the validation rule within its timeout and returns its result at the given level.
+func verifyRule(ctx context.Context, rule validators.Rule, level constants.ValidationLevel) validators.Result {
+	result := validators.Result{Name: rule.Name(), Level: validators.LevelName(level), Status: validators.StatusPass, Message: rule.Message()}
+
+	start := time.Now()
+	err := verifyWithTimeout(ctx, rule)
+	result.DurationSeconds = time.Since(start).Seconds()
+
+	if err == nil {
+		return result
+	}
+
+	result.Message = err.Error()
+	result.Hint = rule.Hint()
+
+	switch level {
+	case constants.ValidationLevelError:
+		result.Status = validators.StatusFail
+	case constants.ValidationLevelWarning:
+		result.Status = validators.StatusWarn
+	}
+
+	return result
+}
+
+// verifyWithTimeout verifies the rule, cancelling its context once its timeout elapses.
+// The commands run by the rule are started with the context, hence they are killed on the timeout.
+func verifyWithTimeout(ctx context.Context, rule validators.Rule) error {
+	timeout := validators.Timeout(rule)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- rule.Verify(ctx)
+	}()
+
+	select {
+	case err := <-errCh:
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("%s check timed out after %s", rule.Name(), timeout)
+		}
+
+		return err
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("%s check timed out after %s", rule.Name(), timeout)
+		}
+
+		return fmt.Errorf("%s check cancelled: %w", rule.Name(), ctx.Err())
+	}
+}
+
+func skippedResult(rule validators.Rule, level constants.ValidationLevel, message string) validators.Result {
+	return validators.Result{Name: rule.Name(), Level: validators.LevelName(level), Status: validators.StatusSkipped, Message: message}
+}
+
+// showResult renders the result of the rule in the progress view, if set.
+func showResult(progress *spinner.Progress, result validators.Result) {
+	if progress == nil {
+		return
+	}
+
+	switch result.Status {
+	case validators.StatusPass:
+		progress.Done(result.Name, result.Message)
+	case validators.StatusWarn:
+		progress.Warn(result.Name, "Warning: "+result.Message, result.Hint)
+	case validators.StatusFail:
+		progress.Fail(result.Name, result.Message, result.Hint)
+	case validators.StatusSkipped:
+		progress.Skip(result.Name, result.Message)
+	}
+}
+
+// newProgress returns the progress view of the rules if interactive, nil otherwise.
+func newProgress(rules []validators.Rule, interactive bool) *spinner.Progress {
+	if !interactive {
+		return nil
+	}
+
+	names := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		names = append(names, rule.Name())
+	}
+
+	return spinner.NewProgress(names...)
+}
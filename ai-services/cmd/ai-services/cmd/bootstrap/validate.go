@@ -3,13 +3,15 @@ package bootstrap
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
-	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/output"
 	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/external"
 	"github.com/spf13/cobra"
 )
 
@@ -25,9 +27,20 @@ const (
 
 const troubleshootingGuide = "https://www.ibm.com/docs/aiservices?topic=services-troubleshooting"
 
+// validateOptions holds the flags of the validate subcommand.
+type validateOptions struct {
+	skipChecks  []string
+	profileName string
+	profile     *validators.Profile
+	junitPath   string
+	fix         bool
+	autoYes     bool
+	output      output.Options
+}
+
 // validateCmd represents the validate subcommand of bootstrap.
 func validateCmd() *cobra.Command {
-	var skipChecks []string
+	opts := &validateOptions{}
 
 	cmd := &cobra.Command{
 		Use:     "validate",
@@ -35,20 +48,28 @@ func validateCmd() *cobra.Command {
 		Long:    longDescription(),
 		Example: example(),
 		Hidden:  true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if opts.fix && opts.output.IsStructured() && !opts.autoYes {
+				return fmt.Errorf("--yes flag is required to apply the fixes along with the structured output")
+			}
+
+			profile, err := validators.LoadProfile(opts.profileName)
+			if err != nil {
+				return err
+			}
+			opts.profile = profile
+
+			return opts.output.Validate()
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Once precheck passes, silence usage for any *later* internal errors.
 			cmd.SilenceUsage = true
 
-			logger.Infoln("Running bootstrap validation...")
-
-			skip := helpers.ParseSkipChecks(skipChecks)
-			if len(skip) > 0 {
-				logger.Warningln("Skipping validation checks: " + strings.Join(skipChecks, ", "))
-			}
-
-			err := RunValidateCmd(skip)
+			err := runValidateWithOptions(cmd.Context(), opts)
 			if err != nil {
-				logger.Infof("Please refer to troubleshooting guide for more information: %s", troubleshootingGuide)
+				if !opts.output.IsStructured() {
+					logger.Infof("Please refer to troubleshooting guide for more information: %s", troubleshootingGuide)
+				}
 
 				return fmt.Errorf("bootstrap validation failed: %w", err)
 			}
@@ -58,18 +79,61 @@ func validateCmd() *cobra.Command {
 	}
 
 	skipCheckDesc := BuildSkipFlagDescription()
-	cmd.Flags().StringSliceVar(&skipChecks, "skip-validation", []string{}, skipCheckDesc)
+	cmd.Flags().StringSliceVar(&opts.skipChecks, "skip-validation", []string{}, skipCheckDesc)
+	AddProfileFlag(cmd, &opts.profileName)
+	cmd.Flags().StringVar(&opts.junitPath, "junit", "", "Write the validation report in JUnit XML format to the given file")
+	cmd.Flags().BoolVar(&opts.fix, "fix", false, "Apply the known safe fixes for the failed checks and verify them again")
+	cmd.Flags().BoolVarP(&opts.autoYes, "yes", "y", false, "Automatically accept all confirmation prompts (default=false)")
+	output.AddFlags(cmd, &opts.output)
 
 	return cmd
 }
 
+// runValidateWithOptions runs the validation checks, applies the fixes if requested and emits the report.
+func runValidateWithOptions(ctx context.Context, opts *validateOptions) error {
+	skip := helpers.ParseSkipChecks(opts.skipChecks)
+	interactive := !opts.output.IsStructured()
+
+	if interactive {
+		logger.Infoln("Running bootstrap validation...")
+
+		if len(skip) > 0 {
+			logger.Warningln("Skipping validation checks: " + strings.Join(opts.skipChecks, ", "))
+		}
+	}
+
+	report, err := runValidation(ctx, skip, opts.profile, interactive)
+	if opts.fix && hasIssues(report) {
+		if fixErr := runFixes(ctx, report, opts.profile, opts.autoYes, interactive); fixErr != nil {
+			return fixErr
+		}
+		err = reportError(report)
+	}
+
+	if !interactive {
+		if printErr := opts.output.Print("ValidationReport", report); printErr != nil {
+			return printErr
+		}
+	}
+
+	if opts.junitPath != "" {
+		if junitErr := report.WriteJUnit(opts.junitPath); junitErr != nil {
+			return junitErr
+		}
+	}
+
+	return err
+}
+
 func longDescription() string {
 	validationList := generateValidationList()
 
 	return fmt.Sprintf(`Validates all prerequisites and configurations are correct for bootstrapping. 
 
 Following scenarios are validated and are available for skipping using --skip-validation flag:
-%s`, validationList)
+%s
+
+Site specific checks can be declared in %s/*.yaml`, validationList, external.RulesDir)
 }
 
 func example() string {
@@ -83,50 +147,145 @@ func example() string {
   ai-services bootstrap validate --skip-validation rhn,power
   
   # Run with verbose output
-  ai-services bootstrap validate --verbose`
+  ai-services bootstrap validate --verbose
+
+  # Emit the per check results as json and as a JUnit XML report
+  ai-services bootstrap validate -o json --junit report.xml
+
+  # Apply the known safe fixes for the failed checks without prompting
+  ai-services bootstrap validate --fix --yes
+
+  # Only warn about the checks lab systems are not expected to meet
+  ai-services bootstrap validate --validation-profile lab
+
+  # Override the levels of the checks with a profile file
+  ai-services bootstrap validate --validation-profile site.yaml`
 }
 
-func RunValidateCmd(skip map[string]bool) error {
-	var validationErrors []error
-	ctx := context.Background()
+// RunValidateCmd runs the registered validation checks at the levels of the given profile and returns the report of the run.
+// Returns an error if any of the error level checks failed.
+func RunValidateCmd(ctx context.Context, skip map[string]bool, profile *validators.Profile) (*validators.Report, error) {
+	return runValidation(ctx, skip, profile, true)
+}
 
-	for _, rule := range validators.DefaultRegistry.Rules() {
-		ruleName := rule.Name()
-		if skip[ruleName] {
-			logger.Warningf("%s check skipped; Proceeding without validation may result in deployment failure.", ruleName)
+// AddProfileFlag adds the --validation-profile flag to the command.
+func AddProfileFlag(cmd *cobra.Command, profile *string) {
+	cmd.Flags().StringVar(profile, "validation-profile", validators.ProfileDefault,
+		fmt.Sprintf("Validation profile overriding the level of the checks (%s) or a yaml file declaring the levels", strings.Join(validators.ProfileNames(), ", ")))
+}
 
+// runValidation runs the registered validation checks and collects the result of each check in the report.
+// The root check is verified first as the other checks require root privileges, the others run concurrently.
+// The live progress is rendered only if interactive is set.
+func runValidation(ctx context.Context, skip map[string]bool, profile *validators.Profile, interactive bool) (*validators.Report, error) {
+	report := validators.NewReport(profile.Name)
+
+	if err := validators.ExternalRulesError(); err != nil {
+		logger.Warningf("skipped invalid validation rules from %s: %v\n", external.RulesDir, err)
+	}
+
+	rootRules, rules := registeredRules()
+	progress := newProgress(append(rootRules, rules...), interactive)
+	if progress != nil {
+		progress.Start(ctx)
+	}
+
+	results, rootFailed := runRootFirst(ctx, rootRules, rules, skip, profile, progress)
+
+	if progress != nil {
+		progress.Stop()
+	}
+
+	for _, result := range results {
+		report.Add(result)
+	}
+
+	if rootFailed {
+		return report, fmt.Errorf("root privileges are required for validation")
+	}
+
+	if err := reportError(report); err != nil {
+		return report, err
+	}
+
+	if interactive {
+		logger.Infoln("All validations passed")
+	}
+
+	return report, nil
+}
+
+// CollectValidation runs the registered validation checks at the levels of the given profile without rendering
+// their progress and returns the report of the run. The checks named in exclude are left out of the run.
+func CollectValidation(ctx context.Context, profile *validators.Profile, exclude ...string) *validators.Report {
+	report := validators.NewReport(profile.Name)
+
+	rootRules, rules := registeredRules(exclude...)
+	results, _ := runRootFirst(ctx, rootRules, rules, nil, profile, nil)
+	for _, result := range results {
+		report.Add(result)
+	}
+
+	return report
+}
+
+// registeredRules returns the root rule apart from the other registered rules, leaving out the rules named in exclude.
+func registeredRules(exclude ...string) ([]validators.Rule, []validators.Rule) {
+	var rootRules, rules []validators.Rule
+	for _, rule := range validators.DefaultRegistry.Rules() {
+		switch {
+		case slices.Contains(exclude, rule.Name()):
 			continue
+		case rule.Name() == CheckRoot:
+			rootRules = append(rootRules, rule)
+		default:
+			rules = append(rules, rule)
 		}
+	}
 
-		s := spinner.New("Validating " + ruleName + " ...")
-		s.Start(ctx)
-		err := rule.Verify()
+	return rootRules, rules
+}
 
-		if err != nil {
-			s.Fail(err.Error())
-			s.StopWithHint(err.Error(), rule.Hint())
+// runRootFirst verifies the root rules first and the others only if they passed, as they require root privileges.
+// Reports whether the root rules failed.
+func runRootFirst(ctx context.Context, rootRules, rules []validators.Rule, skip map[string]bool, profile *validators.Profile, progress *spinner.Progress) ([]validators.Result, bool) {
+	results := runRules(ctx, rootRules, skip, profile, progress)
+	rootFailed := len(results) > 0 && results[0].Status == validators.StatusFail
+	if !rootFailed {
+		return append(results, runRules(ctx, rules, skip, profile, progress)...), false
+	}
 
-			// exit right away if user is not root as other checks require root privileges
-			if ruleName == CheckRoot {
-				return fmt.Errorf("root privileges are required for validation")
-			}
-			switch rule.Level() {
-			case constants.ValidationLevelError:
-				s.Fail(err.Error())
-				validationErrors = append(validationErrors, fmt.Errorf("%s: %w", ruleName, err))
-			case constants.ValidationLevelWarning:
-				s.Stop("Warning: " + err.Error())
-			}
-		} else {
-			s.Stop(rule.Message())
-		}
+	// exit right away if user is not root as other checks require root privileges
+	for _, rule := range rules {
+		result := skippedResult(rule, profile.LevelOf(rule), fmt.Sprintf("%s check skipped as the %s check failed", rule.Name(), CheckRoot))
+		showResult(progress, result)
+		results = append(results, result)
 	}
 
-	if len(validationErrors) > 0 {
-		return fmt.Errorf("%d validation check(s) failed", len(validationErrors))
+	return results, true
+}
+
+// RunRules runs the given validation rules, such as the host requirements of an application, and adds their results to the report.
+// Returns an error if any of the error level checks failed in the report.
+// The levels of the rules are overridden by the profile of the report.
+func RunRules(ctx context.Context, report *validators.Report, rules []validators.Rule, skip map[string]bool, profile *validators.Profile) error {
+	progress := newProgress(rules, true)
+	progress.Start(ctx)
+	results := runRules(ctx, rules, skip, profile, progress)
+	progress.Stop()
+
+	for _, result := range results {
+		report.Add(result)
 	}
 
-	logger.Infoln("All validations passed")
+	return reportError(report)
+}
+
+// reportError returns an error if any of the error level checks failed in the report.
+func reportError(report *validators.Report) error {
+	if failed := report.Count(validators.StatusFail); failed > 0 {
+		return fmt.Errorf("%d validation check(s) failed", failed)
+	}
 
 	return nil
 }
@@ -156,12 +315,15 @@ func generateValidationList() string {
 	return b.String()
 }
 
-func BuildSkipFlagDescription() string {
+// BuildSkipFlagDescription returns the description of the --skip-validation flag listing the registered rules
+// along with the given additional rule names.
+func BuildSkipFlagDescription(additional ...string) string {
 	rules := validators.DefaultRegistry.Rules()
-	ruleName := make([]string, 0, len(rules))
+	ruleName := make([]string, 0, len(rules)+len(additional))
 	for _, rule := range rules {
 		ruleName = append(ruleName, rule.Name())
 	}
+	ruleName = append(ruleName, additional...)
 
 	return fmt.Sprintf("Skip specific validation checks (comma-separated: %s)", strings.Join(ruleName, ","))
 }
@@ -0,0 +1,35 @@
+package bundle
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// BundleCmd represents the bundle command.
+func BundleCmd() *cobra.Command {
+	bundleCmd := &cobra.Command{
+		Use:   "bundle",
+		Short: "Manage bundles for air-gapped deployments",
+		Long: `The bundle command packs everything required to deploy an application template on an LPAR
+without internet access into a single archive:
+  • The podman RPMs along with their dependencies
+  • The container images of the template, including the tool image
+  • The models of the template
+
+The bundle is consumed by 'ai-services bootstrap configure --bundle' and 'ai-services application create --bundle'.`,
+		Example: `  # Create a bundle for the rag template on a system with internet access
+  ai-services bundle create --template rag
+
+  # Configure and deploy on the air-gapped LPAR
+  ai-services bootstrap configure --bundle ai-services-rag-bundle.tar
+  ai-services application create myapp --template rag --bundle ai-services-rag-bundle.tar`,
+		Args: cobra.MaximumNArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	// subcommands
+	bundleCmd.AddCommand(createCmd())
+
+	return bundleCmd
+}
@@ -0,0 +1,75 @@
+package bundle
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/bundle"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators"
+)
+
+// createCmd represents the create subcommand of bundle.
+func createCmd() *cobra.Command {
+	var (
+		templateName string
+		output       string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Creates a bundle for an application template",
+		Long: `Creates a bundle archive with the podman RPMs, the container images and the models of the application template.
+
+The bundle is created on a system with internet access and the same architecture as the air-gapped LPAR.
+The images and the models not present locally are downloaded first.`,
+		Example: `  # Create a bundle for the rag template
+  ai-services bundle create --template rag
+
+  # Create the bundle at a given path
+  ai-services bundle create --template rag --file /mnt/usb/rag.tar`,
+		Args: cobra.MaximumNArgs(0),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+
+			return validators.ValidateAppTemplateExist(tp, templateName)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Once precheck passes, silence usage for any *later* internal errors.
+			cmd.SilenceUsage = true
+
+			if output == "" {
+				output = fmt.Sprintf("ai-services-%s-bundle.tar", templateName)
+			}
+			output, err := filepath.Abs(output)
+			if err != nil {
+				return fmt.Errorf("failed to resolve bundle path: %w", err)
+			}
+
+			runtime, err := podman.NewPodmanClient()
+			if err != nil {
+				return fmt.Errorf("failed to connect to podman: %w", err)
+			}
+
+			opts := bundle.CreateOptions{Template: templateName, Output: output}
+			if err := bundle.Create(cmd.Context(), host.Local(), runtime, opts); err != nil {
+				return fmt.Errorf("failed to create bundle: %w", err)
+			}
+
+			logger.Infof("Bundle created successfully: %s\n", output)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&templateName, "template", "t", "", "Application template to bundle (required)")
+	_ = cmd.MarkFlagRequired("template")
+	cmd.Flags().StringVar(&output, "file", "", "Path of the bundle archive to create (default \"ai-services-<template>-bundle.tar\")")
+
+	return cmd
+}
@@ -0,0 +1,72 @@
+package connection
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/connection"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+// addCmd represents the add subcommand of connection.
+func addCmd() *cobra.Command {
+	var (
+		identity   string
+		setDefault bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "add [name] [uri]",
+		Short: "Adds a named Podman connection",
+		Long: `Adds a named Podman connection
+
+Arguments
+  [name]: Connection name (required)
+  [uri]:  Podman endpoint URI, for example unix:///run/podman/podman.sock or ssh://root@host/run/podman/podman.sock (required)
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Once precheck passes, silence usage for any *later* internal errors.
+			cmd.SilenceUsage = true
+
+			if identity != "" {
+				abs, err := filepath.Abs(identity)
+				if err != nil {
+					return fmt.Errorf("failed to resolve identity file path: %w", err)
+				}
+				identity = abs
+			}
+
+			cfg, err := connection.Load()
+			if err != nil {
+				return err
+			}
+
+			conn := connection.Connection{Name: args[0], URI: args[1], Identity: identity}
+			if err := cfg.Add(conn); err != nil {
+				return fmt.Errorf("failed to add connection: %w", err)
+			}
+
+			if setDefault {
+				if err := cfg.Use(conn.Name); err != nil {
+					return err
+				}
+			}
+
+			if err := cfg.Save(); err != nil {
+				return err
+			}
+
+			logger.Infof("Connection '%s' added successfully\n", conn.Name)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&identity, "identity", "", "Path to the ssh identity file (only for ssh connections)")
+	cmd.Flags().BoolVar(&setDefault, "default", false, "Set the connection as the default connection")
+
+	return cmd
+}
@@ -0,0 +1,44 @@
+package connection
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// ConnectionCmd represents the connection command.
+func ConnectionCmd() *cobra.Command {
+	connectionCmd := &cobra.Command{
+		Use:   "connection",
+		Short: "Manage named Podman connections",
+		Long: `The connection command manages named Podman endpoints (local unix sockets or remote ssh hosts)
+used by the other ai-services commands.
+
+The connection to use is picked in the following order:
+  1. --connection flag
+  2. CONTAINER_HOST (and CONTAINER_SSHKEY) environment variables
+  3. Default connection set via 'ai-services connection use'
+  4. Local podman socket (unix:///run/podman/podman.sock)`,
+		Example: `  # Add a remote LPAR reachable over ssh
+  ai-services connection add lpar1 ssh://root@lpar1.example.com/run/podman/podman.sock --identity ~/.ssh/id_ed25519
+
+  # List the connections
+  ai-services connection list
+
+  # Make lpar1 the default connection
+  ai-services connection use lpar1
+
+  # Run a single command against another connection
+  ai-services application ps --connection lpar2`,
+		Args: cobra.MaximumNArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	// subcommands
+	connectionCmd.AddCommand(addCmd())
+	connectionCmd.AddCommand(listCmd())
+	connectionCmd.AddCommand(useCmd())
+	connectionCmd.AddCommand(removeCmd())
+
+	return connectionCmd
+}
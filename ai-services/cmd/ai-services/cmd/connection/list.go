@@ -0,0 +1,74 @@
+package connection
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/connection"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/output"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+// connectionInfo is the machine-readable representation of a named connection.
+type connectionInfo struct {
+	Name     string `json:"name"`
+	URI      string `json:"uri"`
+	Identity string `json:"identity,omitempty"`
+	Default  bool   `json:"default"`
+}
+
+// listCmd represents the list subcommand of connection.
+func listCmd() *cobra.Command {
+	var outputOpts output.Options
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "Lists the named Podman connections",
+		Args:    cobra.MaximumNArgs(0),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return outputOpts.Validate()
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Once precheck passes, silence usage for any *later* internal errors.
+			cmd.SilenceUsage = true
+
+			cfg, err := connection.Load()
+			if err != nil {
+				return err
+			}
+
+			conns := cfg.List()
+			if outputOpts.IsStructured() {
+				connInfos := make([]connectionInfo, 0, len(conns))
+				for _, conn := range conns {
+					connInfos = append(connInfos, connectionInfo{Name: conn.Name, URI: conn.URI, Identity: conn.Identity, Default: conn.Name == cfg.Default})
+				}
+
+				return outputOpts.Print("ConnectionList", connInfos)
+			}
+
+			if len(conns) == 0 {
+				logger.Infoln("No connections found. Please use 'ai-services connection add' to add a connection.")
+
+				return nil
+			}
+
+			p := utils.NewTableWriter()
+			defer p.CloseTableWriter()
+
+			p.SetHeaders("NAME", "URI", "IDENTITY", "DEFAULT")
+			for _, conn := range conns {
+				p.AppendRow(conn.Name, conn.URI, conn.Identity, fmt.Sprintf("%t", conn.Name == cfg.Default))
+			}
+
+			return nil
+		},
+	}
+
+	output.AddFlags(cmd, &outputOpts)
+
+	return cmd
+}
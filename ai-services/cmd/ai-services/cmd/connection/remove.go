@@ -0,0 +1,46 @@
+package connection
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/connection"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+// removeCmd represents the remove subcommand of connection.
+func removeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove [name]",
+		Aliases: []string{"rm"},
+		Short:   "Removes a named Podman connection",
+		Long: `Removes a named Podman connection
+
+Arguments
+  [name]: Connection name (required)
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Once precheck passes, silence usage for any *later* internal errors.
+			cmd.SilenceUsage = true
+
+			cfg, err := connection.Load()
+			if err != nil {
+				return err
+			}
+
+			if err := cfg.Remove(args[0]); err != nil {
+				return err
+			}
+
+			if err := cfg.Save(); err != nil {
+				return err
+			}
+
+			logger.Infof("Connection '%s' removed successfully\n", args[0])
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,45 @@
+package connection
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/connection"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+// useCmd represents the use subcommand of connection.
+func useCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "use [name]",
+		Short: "Sets the default Podman connection",
+		Long: `Sets the default Podman connection used when --connection flag is not provided
+
+Arguments
+  [name]: Connection name (required)
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Once precheck passes, silence usage for any *later* internal errors.
+			cmd.SilenceUsage = true
+
+			cfg, err := connection.Load()
+			if err != nil {
+				return err
+			}
+
+			if err := cfg.Use(args[0]); err != nil {
+				return err
+			}
+
+			if err := cfg.Save(); err != nil {
+				return err
+			}
+
+			logger.Infof("Default connection set to '%s'\n", args[0])
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"flag"
 	"os"
 
@@ -8,10 +10,18 @@ import (
 
 	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/application"
 	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/bootstrap"
+	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/bundle"
+	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/connection"
+	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/supportbundle"
+	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/system"
 	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/version"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
+// cancelTimeout releases the resources associated with the --timeout context.
+var cancelTimeout context.CancelFunc = func() {}
+
 // RootCmd represents the base command when called without any subcommands.
 var RootCmd = &cobra.Command{
 	Use:     "ai-services",
@@ -21,6 +31,13 @@ var RootCmd = &cobra.Command{
 	PersistentPreRun: func(cmd *cobra.Command, args []string) {
 		// Ensures logs flush after each command run
 		logger.Infoln("Logger initialized (PersistentPreRun)", logger.VerbosityLevelDebug)
+
+		// Bound the overall command execution if --timeout is set
+		if vars.Timeout > 0 {
+			ctx, cancel := context.WithTimeout(cmd.Context(), vars.Timeout)
+			cancelTimeout = cancel
+			cmd.SetContext(ctx)
+		}
 	},
 }
 
@@ -28,8 +45,16 @@ var RootCmd = &cobra.Command{
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
 	defer logger.Flush()
-	err := RootCmd.Execute()
-	if err != nil {
+	err := RootCmd.ExecuteContext(context.Background())
+	cancelTimeout()
+
+	var exitErr *application.ExitCodeError
+	switch {
+	case errors.As(err, &exitErr):
+		logger.Flush()
+		os.Exit(exitErr.Code)
+	case err != nil:
+		logger.Flush()
 		os.Exit(1)
 	}
 }
@@ -37,7 +62,13 @@ func Execute() {
 func init() {
 	logger.Init()
 	RootCmd.PersistentFlags().AddGoFlagSet(flag.CommandLine)
+	RootCmd.PersistentFlags().DurationVar(&vars.Timeout, "timeout", 0, "Maximum duration for the command to complete, e.g. 30m or 1h (default no timeout)")
+	RootCmd.PersistentFlags().StringVar(&vars.Connection, "connection", "", "Name of the Podman connection to use (see 'ai-services connection list')")
 	RootCmd.AddCommand(version.VersionCmd)
 	RootCmd.AddCommand(bootstrap.BootstrapCmd())
 	RootCmd.AddCommand(application.ApplicationCmd)
+	RootCmd.AddCommand(connection.ConnectionCmd())
+	RootCmd.AddCommand(bundle.BundleCmd())
+	RootCmd.AddCommand(system.SystemCmd())
+	RootCmd.AddCommand(supportbundle.SupportBundleCmd())
 }
@@ -0,0 +1,194 @@
+package supportbundle
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/bootstrap"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
+	"github.com/project-ai-services/ai-services/internal/pkg/support"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators"
+)
+
+// checkServiceReport is the validation check running the ServiceReport tool, its output is collected on its own.
+const checkServiceReport = "servicereport"
+
+type options struct {
+	output         string
+	rawMaxLogSize  string
+	maxLogSize     int64
+	skipValidation bool
+}
+
+// SupportBundleCmd represents the support-bundle command.
+func SupportBundleCmd() *cobra.Command {
+	opts := &options{}
+
+	cmd := &cobra.Command{
+		Use:   "support-bundle [app]",
+		Short: "Collects the diagnostics of the LPAR and the applications into a single archive",
+		Long: `Collects the diagnostics required to debug a deployment into a single archive to attach to a support case:
+  • The summary of the LPAR, the validation results and the output of the ServiceReport tool
+  • The host configuration recorded by bootstrap configure and the SMT manager
+  • The pod and container inspect output along with the container logs of the applications
+  • The values and the rendered pod templates of the applications, along with their validation report
+
+All the applications are collected unless an application is given.
+Secrets and tokens are redacted from the env vars, the values, the templates and the logs. The logs of each container
+are capped, keeping their end. The archive holds a manifest listing its files along with the items which could not
+be collected.`,
+		Example: `  # Collect the diagnostics of the LPAR and all the applications
+  ai-services support-bundle
+
+  # Collect the diagnostics of the rag application
+  ai-services support-bundle rag --out rag-support.tar.gz
+
+  # Keep up to 50MB of logs per container
+  ai-services support-bundle rag --max-log-size 50MB`,
+		Args: cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			size, err := units.RAMInBytes(opts.rawMaxLogSize)
+			if err != nil || size <= 0 {
+				return fmt.Errorf("invalid --max-log-size '%s': must be a positive size, Eg:- 10MB", opts.rawMaxLogSize)
+			}
+			opts.maxLogSize = size
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Once precheck passes, silence usage for any *later* internal errors.
+			cmd.SilenceUsage = true
+
+			app := ""
+			if len(args) > 0 {
+				app = args[0]
+			}
+
+			return run(cmd.Context(), opts, app)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.output, "out", "", "Path of the support bundle to create (default \"ai-services-support-<timestamp>.tar.gz\")")
+	cmd.Flags().StringVar(&opts.rawMaxLogSize, "max-log-size", units.BytesSize(support.DefaultMaxLogSize), "Size the logs of each container are capped at, keeping their end")
+	cmd.Flags().BoolVar(&opts.skipValidation, "skip-validation", false, "Skip running the validation checks on the LPAR")
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *options, app string) error {
+	output := opts.output
+	if output == "" {
+		output = fmt.Sprintf("ai-services-support-%s.tar.gz", time.Now().Format("20060102-150405"))
+	}
+	output, err := filepath.Abs(output)
+	if err != nil {
+		return fmt.Errorf("failed to resolve support bundle path: %w", err)
+	}
+
+	b, err := support.Create(output)
+	if err != nil {
+		return err
+	}
+
+	// the host is collected even if podman is not reachable, as it may be the cause of the failure
+	var rt runtime.Runtime
+	if client, err := podman.NewPodmanClient(); err != nil {
+		b.AddError("podman", fmt.Errorf("failed to connect to podman: %w", err))
+	} else {
+		rt = client
+	}
+
+	collect(ctx, b, rt, opts, app)
+
+	if ctx.Err() != nil {
+		b.Discard()
+
+		return fmt.Errorf("support bundle collection interrupted: %w", ctx.Err())
+	}
+
+	manifest := b.Manifest()
+	if err := b.Close(); err != nil {
+		b.Discard()
+
+		return err
+	}
+
+	if len(manifest.Errors) > 0 {
+		logger.Warningf("%d item(s) could not be collected, they are listed in the manifest of the support bundle\n", len(manifest.Errors))
+	}
+	logger.Infof("Support bundle created successfully: %s\n", output)
+
+	return nil
+}
+
+func collect(ctx context.Context, b *support.Bundle, rt runtime.Runtime, opts *options, app string) {
+	s := spinner.New("Collecting the LPAR information")
+	s.Start(ctx)
+	b.CollectHost(ctx, host.Local(), rt)
+	s.Stop("Collected the LPAR information")
+
+	if !opts.skipValidation {
+		s = spinner.New("Running the validation checks")
+		s.Start(ctx)
+		report := bootstrap.CollectValidation(ctx, validators.DefaultProfile(), checkServiceReport)
+		b.AddJSON("host/"+constants.ValidationReportFile, report)
+		s.Stop("Ran the validation checks")
+	}
+
+	apps := []string{app}
+	if app == "" {
+		apps = listApplications(ctx, rt)
+	}
+
+	for _, name := range apps {
+		if ctx.Err() != nil {
+			return
+		}
+		s = spinner.New("Collecting application " + name)
+		s.Start(ctx)
+		b.CollectApplication(ctx, rt, name, opts.maxLogSize)
+		s.Stop("Collected application " + name)
+	}
+}
+
+// listApplications returns the names of the applications with pods or an application directory on the LPAR,
+// as a failed deployment may be left without pods.
+func listApplications(ctx context.Context, rt runtime.Runtime) []string {
+	var apps []string
+	if rt != nil {
+		pods, err := rt.ListPods(ctx, map[string][]string{"label": {constants.ApplicationAnnotationKey}})
+		if err != nil {
+			logger.Warningf("failed to list pods: %v\n", err)
+		}
+		for _, pod := range pods {
+			if name := pod.Labels[constants.ApplicationAnnotationKey]; name != "" && !slices.Contains(apps, name) {
+				apps = append(apps, name)
+			}
+		}
+	}
+
+	entries, err := os.ReadDir(constants.ApplicationsPath)
+	if err != nil && !os.IsNotExist(err) {
+		logger.Warningf("failed to list application directories: %v\n", err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() && !slices.Contains(apps, entry.Name()) {
+			apps = append(apps, entry.Name())
+		}
+	}
+	slices.Sort(apps)
+
+	return apps
+}
@@ -0,0 +1,142 @@
+package system
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/docker/go-units"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/output"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/sysinfo"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// infoCmd represents the info subcommand of system.
+func infoCmd() *cobra.Command {
+	var outputOpts output.Options
+
+	cmd := &cobra.Command{
+		Use:   "info",
+		Short: "Shows a summary of the LPAR",
+		Long: `Shows a summary of the LPAR to attach to a support case:
+  • Power generation, RHEL version and NUMA layout
+  • SMT level, along with the level before ai-services changed it
+  • Spyre cards and the kernel driver they are bound to
+  • Podman version and the state of its socket
+  • Memory and the free disk space under the data path
+  • Deployed applications
+
+A probe failing does not stop the others, its error is reported along with the summary.`,
+		Example: `  # Show the summary of the LPAR
+  ai-services system info
+
+  # Save the summary as json to attach it to a support case
+  ai-services system info -o json > system-info.json`,
+		Args: cobra.MaximumNArgs(0),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return outputOpts.Validate()
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Once precheck passes, silence usage for any *later* internal errors.
+			cmd.SilenceUsage = true
+
+			// the applications are skipped if podman is not reachable, the host probes are gathered regardless
+			client, clientErr := podman.NewPodmanClient()
+			var info *sysinfo.Info
+			if clientErr != nil {
+				info = sysinfo.Collect(cmd.Context(), host.Local(), nil)
+				info.Errors[sysinfo.ProbeApplications] = fmt.Sprintf("failed to connect to podman: %v", clientErr)
+			} else {
+				info = sysinfo.Collect(cmd.Context(), host.Local(), client)
+			}
+
+			if outputOpts.IsStructured() {
+				return outputOpts.Print("SystemInfo", info)
+			}
+			printInfo(info)
+
+			return nil
+		},
+	}
+
+	output.AddFlags(cmd, &outputOpts)
+
+	return cmd
+}
+
+func printInfo(info *sysinfo.Info) {
+	p := utils.NewTableWriter()
+	defer p.CloseTableWriter()
+	p.SetHeaders("PROPERTY", "VALUE")
+
+	p.AppendRow("Architecture", info.Arch)
+	p.AppendRow("Power", valueOrDash(info.Power))
+	p.AppendRow("Operating system", valueOrDash(info.OS.Name+" "+info.OS.Version))
+
+	if info.NUMA != nil {
+		p.AppendRow("NUMA nodes", strconv.Itoa(info.NUMA.Nodes))
+		for _, node := range slices.Sorted(maps.Keys(info.NUMA.CPUs)) {
+			p.AppendRow("NUMA CPUs", node+": "+info.NUMA.CPUs[node])
+		}
+	} else {
+		p.AppendRow("NUMA nodes", "-")
+	}
+
+	p.AppendRow("SMT level", levelOrDash(info.SMT.Current))
+	p.AppendRow("SMT original level", levelOrDash(info.SMT.Original))
+
+	if len(info.Spyre) == 0 {
+		p.AppendRow("Spyre cards", "-")
+	}
+	for _, card := range info.Spyre {
+		p.AppendRow("Spyre cards", card.Address+" ("+valueOrDash(card.Driver)+")")
+	}
+
+	p.AppendRow("Podman version", valueOrDash(info.Podman.Version))
+	p.AppendRow("Podman API version", valueOrDash(info.Podman.APIVersion))
+	p.AppendRow("Podman socket", valueOrDash(info.Podman.Socket))
+
+	p.AppendRow("Memory total", bytesOrDash(info.Memory.Total))
+	p.AppendRow("Memory available", bytesOrDash(info.Memory.Available))
+	p.AppendRow("Disk free", bytesOrDash(info.Disk.Free)+" under "+info.Disk.Path)
+
+	if len(info.Applications) == 0 {
+		p.AppendRow("Applications", "-")
+	}
+	for _, app := range info.Applications {
+		p.AppendRow("Applications", fmt.Sprintf("%s (%d pods)", app.Name, app.Pods))
+	}
+
+	for _, probe := range slices.Sorted(maps.Keys(info.Errors)) {
+		p.AppendRow("Errors", probe+": "+info.Errors[probe])
+	}
+}
+
+func valueOrDash(value string) string {
+	if value == "" || value == " " {
+		return "-"
+	}
+
+	return value
+}
+
+func levelOrDash(level int) string {
+	if level == 0 {
+		return "-"
+	}
+
+	return strconv.Itoa(level)
+}
+
+func bytesOrDash(size uint64) string {
+	if size == 0 {
+		return "-"
+	}
+
+	return units.BytesSize(float64(size))
+}
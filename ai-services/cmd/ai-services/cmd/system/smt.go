@@ -0,0 +1,200 @@
+package system
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/output"
+	"github.com/project-ai-services/ai-services/internal/pkg/smt"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/root"
+	"github.com/spf13/cobra"
+)
+
+// smtInfo is the machine-readable representation of the SMT level of the LPAR.
+type smtInfo struct {
+	Current      int            `json:"current"`
+	Original     int            `json:"original,omitempty"`
+	Applications map[string]int `json:"applications,omitempty"`
+	Persist      bool           `json:"persist"`
+}
+
+// smtCmd represents the smt subcommand of system.
+func smtCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "smt",
+		Short: "Manage the SMT level of the LPAR",
+		Long: `Manage the SMT level of the LPAR.
+
+The SMT level is changed by 'ai-services application create' when the template requires one. The level before the
+first change is recorded and restored once the last application requiring a level is deleted.`,
+		Example: `  # Show the current and the original SMT level along with the applications requiring it
+  ai-services system smt show
+
+  # Set the SMT level to 2 and keep it across reboots
+  ai-services system smt set 2 --persist
+
+  # Restore the original SMT level
+  ai-services system smt restore`,
+		Args: cobra.MaximumNArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(smtShowCmd(), smtSetCmd(), smtRestoreCmd())
+
+	return cmd
+}
+
+func smtShowCmd() *cobra.Command {
+	var outputOpts output.Options
+
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Shows the SMT level of the LPAR",
+		Args:  cobra.MaximumNArgs(0),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return outputOpts.Validate()
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Once precheck passes, silence usage for any *later* internal errors.
+			cmd.SilenceUsage = true
+
+			return showSMT(cmd.Context(), &outputOpts)
+		},
+	}
+
+	output.AddFlags(cmd, &outputOpts)
+
+	return cmd
+}
+
+func showSMT(ctx context.Context, outputOpts *output.Options) error {
+	manager := smt.NewManager(host.Local())
+	current, err := manager.Current(ctx)
+	if err != nil {
+		return err
+	}
+
+	state, err := manager.State()
+	if err != nil {
+		return err
+	}
+
+	info := smtInfo{Current: current, Original: state.Original, Applications: state.Applications, Persist: state.Persist}
+	if outputOpts.IsStructured() {
+		return outputOpts.Print("SMT", info)
+	}
+
+	original := "-"
+	if info.Original != 0 {
+		original = strconv.Itoa(info.Original)
+	}
+
+	p := utils.NewTableWriter()
+	defer p.CloseTableWriter()
+	p.SetHeaders("CURRENT", "ORIGINAL", "PERSISTED", "APPLICATION", "REQUIRED")
+	if len(info.Applications) == 0 {
+		p.AppendRow(strconv.Itoa(current), original, strconv.FormatBool(info.Persist), "-", "-")
+
+		return nil
+	}
+	for _, app := range slices.Sorted(maps.Keys(info.Applications)) {
+		p.AppendRow(strconv.Itoa(current), original, strconv.FormatBool(info.Persist), app, strconv.Itoa(info.Applications[app]))
+	}
+
+	return nil
+}
+
+func smtSetCmd() *cobra.Command {
+	var persist bool
+
+	cmd := &cobra.Command{
+		Use:   "set <level>",
+		Short: "Sets the SMT level of the LPAR",
+		Long: `Sets the SMT level of the LPAR, recording the original level to restore it later.
+
+The level is refused if it conflicts with the level required by a deployed application.`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			level, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid SMT level '%s': %w", args[0], err)
+			}
+
+			if err := smt.ValidateLevel(level); err != nil {
+				return err
+			}
+
+			return root.NewRootRule(host.Local()).Verify(cmd.Context())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Once precheck passes, silence usage for any *later* internal errors.
+			cmd.SilenceUsage = true
+
+			level, _ := strconv.Atoi(args[0])
+			if err := smt.NewManager(host.Local()).Set(cmd.Context(), level, persist); err != nil {
+				return fmt.Errorf("cannot set SMT level %d: %w", level, err)
+			}
+
+			logger.Infof("SMT level set to %d\n", level)
+			if persist {
+				logger.Infof("The level is persisted across reboots by the %s unit\n", smt.UnitName)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&persist, "persist", false, "Persist the SMT level across reboots through a systemd unit")
+
+	return cmd
+}
+
+func smtRestoreCmd() *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restores the original SMT level of the LPAR",
+		Long: `Restores the SMT level recorded before ai-services changed it and removes the persisted level.
+
+The restore is refused while deployed applications require a level, unless --force is set.`,
+		Args: cobra.MaximumNArgs(0),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return root.NewRootRule(host.Local()).Verify(cmd.Context())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Once precheck passes, silence usage for any *later* internal errors.
+			cmd.SilenceUsage = true
+
+			manager := smt.NewManager(host.Local())
+			state, err := manager.State()
+			if err != nil {
+				return err
+			}
+			if state.Original == 0 && !state.Persist {
+				logger.Infoln("SMT level is not changed by ai-services, nothing to restore.")
+
+				return nil
+			}
+
+			if err := manager.Restore(cmd.Context(), force); err != nil {
+				return err
+			}
+			logger.Infof("SMT level restored to %d\n", state.Original)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Restore even if deployed applications require an SMT level")
+
+	return cmd
+}
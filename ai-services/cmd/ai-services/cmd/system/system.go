@@ -0,0 +1,30 @@
+package system
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// SystemCmd represents the system command.
+func SystemCmd() *cobra.Command {
+	systemCmd := &cobra.Command{
+		Use:   "system",
+		Short: "Manage the LPAR settings used by the applications",
+		Long: `The system command inspects and changes the LPAR settings the applications depend on,
+such as the SMT level, and summarizes the LPAR for support cases.`,
+		Example: `  # Show a summary of the LPAR
+  ai-services system info
+
+  # Show the SMT level of the LPAR
+  ai-services system smt show`,
+		Args: cobra.MaximumNArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	// subcommands
+	systemCmd.AddCommand(infoCmd())
+	systemCmd.AddCommand(smtCmd())
+
+	return systemCmd
+}
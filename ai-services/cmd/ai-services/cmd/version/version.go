@@ -2,6 +2,7 @@ package version
 
 import (
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/output"
 	"github.com/spf13/cobra"
 )
 
@@ -11,6 +12,15 @@ var (
 	BuildDate string = ""
 )
 
+var outputOpts output.Options
+
+// versionInfo is the machine-readable representation of the CLI version.
+type versionInfo struct {
+	Version   string `json:"version"`
+	GitCommit string `json:"gitCommit"`
+	BuildDate string `json:"buildDate"`
+}
+
 func GetVersion() string {
 	return Version
 }
@@ -18,7 +28,20 @@ func GetVersion() string {
 var VersionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Prints CLI version with more info",
-	Run: func(cmd *cobra.Command, args []string) {
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return outputOpts.Validate()
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if outputOpts.IsStructured() {
+			return outputOpts.Print("Version", versionInfo{Version: Version, GitCommit: GitCommit, BuildDate: BuildDate})
+		}
+
 		logger.Infof("Version: %s\nGitCommit: %s\nBuildDate: %s\n", Version, GitCommit, BuildDate)
+
+		return nil
 	},
 }
+
+func init() {
+	output.AddFlags(VersionCmd, &outputOpts)
+}
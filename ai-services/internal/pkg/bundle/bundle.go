@@ -0,0 +1,294 @@
+package bundle
+
+import (
+	"archive/tar"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+const (
+	// Version is the version of the bundle layout.
+	Version = 1
+
+	manifestFile = "manifest.json"
+	packagesDir  = "packages"
+	imagesDir    = "images"
+	modelsDir    = "models"
+
+	dirPermissions  = 0o755
+	filePermissions = 0o644
+)
+
+// Manifest describes the content of a bundle. It is the first entry of the bundle archive.
+type Manifest struct {
+	Version   int       `json:"version"`
+	Template  string    `json:"template"`
+	Arch      string    `json:"arch"`
+	CreatedAt time.Time `json:"createdAt"`
+	// Packages are the RPMs required to install podman offline
+	Packages []File  `json:"packages"`
+	Images   []Image `json:"images"`
+	Models   []Model `json:"models"`
+}
+
+// File is a file within the bundle archive along with its checksum.
+type File struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// Image is a container image saved within the bundle archive.
+type Image struct {
+	Name string `json:"name"`
+	File File   `json:"file"`
+}
+
+// Model is a model within the bundle archive along with its files.
+type Model struct {
+	Name string `json:"name"`
+	// Commit is the commit of the model files bundled, empty if unknown
+	Commit string `json:"commit,omitempty"`
+	Files  []File `json:"files"`
+}
+
+// Bundle is a bundle archive holding everything required to deploy a template offline.
+type Bundle struct {
+	path     string
+	Manifest Manifest
+}
+
+// Open reads the manifest of the bundle archive at the given path.
+func Open(bundlePath string) (*Bundle, error) {
+	f, err := os.Open(bundlePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bundle: %w", err)
+	}
+	defer f.Close()
+
+	tr := tar.NewReader(f)
+	hdr, err := tr.Next()
+	if err != nil || hdr.Name != manifestFile {
+		return nil, fmt.Errorf("%s is not a valid bundle: %s not found", bundlePath, manifestFile)
+	}
+
+	var manifest Manifest
+	if err := json.NewDecoder(tr).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("failed to read bundle manifest: %w", err)
+	}
+
+	if manifest.Version != Version {
+		return nil, fmt.Errorf("unsupported bundle version %d, expected %d", manifest.Version, Version)
+	}
+
+	if manifest.Arch != runtime.GOARCH {
+		return nil, fmt.Errorf("bundle is built for %s, the host is %s", manifest.Arch, runtime.GOARCH)
+	}
+
+	b := &Bundle{path: bundlePath, Manifest: manifest}
+	if err := b.validate(); err != nil {
+		return nil, fmt.Errorf("%s is not a valid bundle: %w", bundlePath, err)
+	}
+
+	return b, nil
+}
+
+// validate verifies that the paths listed in the manifest do not escape the directories they are extracted into.
+func (b *Bundle) validate() error {
+	for _, model := range b.Manifest.Models {
+		if !filepath.IsLocal(model.Name) {
+			return fmt.Errorf("invalid model name %s", model.Name)
+		}
+		for _, file := range model.Files {
+			if !strings.HasPrefix(file.Path, path.Join(modelsDir, model.Name)+"/") || !filepath.IsLocal(file.Path) {
+				return fmt.Errorf("invalid path %s for model %s", file.Path, model.Name)
+			}
+		}
+	}
+
+	for p := range b.files() {
+		if !filepath.IsLocal(p) {
+			return fmt.Errorf("invalid path %s", p)
+		}
+	}
+
+	return nil
+}
+
+// Path returns the path of the bundle archive.
+func (b *Bundle) Path() string {
+	return b.path
+}
+
+// HasImage reports whether the image is saved within the bundle.
+func (b *Bundle) HasImage(name string) bool {
+	return slices.ContainsFunc(b.Manifest.Images, func(img Image) bool { return img.Name == name })
+}
+
+// ExtractPackages extracts the RPMs of the bundle into the directory and returns their paths.
+func (b *Bundle) ExtractPackages(dir string) ([]string, error) {
+	targets := map[string]string{}
+	paths := make([]string, 0, len(b.Manifest.Packages))
+	for _, pkg := range b.Manifest.Packages {
+		target := filepath.Join(dir, path.Base(pkg.Path))
+		targets[pkg.Path] = target
+		paths = append(paths, target)
+	}
+
+	if err := b.extract(targets); err != nil {
+		return nil, err
+	}
+
+	return paths, nil
+}
+
+// LoadImages loads the given images of the bundle into the local image store, all the images if none are given.
+func (b *Bundle) LoadImages(ctx context.Context, runner host.CommandRunner, names ...string) error {
+	workDir, err := os.MkdirTemp("", "ai-services-bundle-*")
+	if err != nil {
+		return fmt.Errorf("failed to create working directory: %w", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	for _, img := range b.Manifest.Images {
+		if len(names) > 0 && !slices.Contains(names, img.Name) {
+			continue
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		archive := filepath.Join(workDir, path.Base(img.File.Path))
+		if err := b.extract(map[string]string{img.File.Path: archive}); err != nil {
+			return err
+		}
+
+		logger.Infof("Loading image %s from the bundle\n", img.Name, logger.VerbosityLevelDebug)
+		if out, err := runner.Run(ctx, "podman", "load", "-i", archive); err != nil {
+			return fmt.Errorf("failed to load image %s: %v, output: %s", img.Name, err, string(out))
+		}
+
+		// the archive is no longer required once loaded
+		_ = os.Remove(archive)
+	}
+
+	return nil
+}
+
+// InstallModels extracts the models of the bundle into the model directory.
+func (b *Bundle) InstallModels(modelDir string) error {
+	targets := map[string]string{}
+	for _, model := range b.Manifest.Models {
+		prefix := path.Join(modelsDir, model.Name) + "/"
+		for _, file := range model.Files {
+			targets[file.Path] = filepath.Join(modelDir, model.Name, filepath.FromSlash(strings.TrimPrefix(file.Path, prefix)))
+		}
+	}
+
+	return b.extract(targets)
+}
+
+// extract extracts the archive entries keyed by their path to the target files, verifying their checksum.
+func (b *Bundle) extract(targets map[string]string) error {
+	if len(targets) == 0 {
+		return nil
+	}
+
+	files := b.files()
+	f, err := os.Open(b.path)
+	if err != nil {
+		return fmt.Errorf("failed to open bundle: %w", err)
+	}
+	defer f.Close()
+
+	remaining := len(targets)
+	tr := tar.NewReader(f)
+	for remaining > 0 {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read bundle: %w", err)
+		}
+
+		target, ok := targets[hdr.Name]
+		if !ok {
+			continue
+		}
+		file, ok := files[hdr.Name]
+		if !ok {
+			return fmt.Errorf("%s is not listed in the bundle manifest", hdr.Name)
+		}
+
+		if err := extractFile(tr, target, file); err != nil {
+			return err
+		}
+		remaining--
+	}
+
+	if remaining > 0 {
+		return fmt.Errorf("bundle is incomplete: %d file(s) listed in the manifest are missing", remaining)
+	}
+
+	return nil
+}
+
+// files returns the files listed in the manifest keyed by their path.
+func (b *Bundle) files() map[string]File {
+	files := map[string]File{}
+	for _, pkg := range b.Manifest.Packages {
+		files[pkg.Path] = pkg
+	}
+	for _, img := range b.Manifest.Images {
+		files[img.File.Path] = img.File
+	}
+	for _, model := range b.Manifest.Models {
+		for _, file := range model.Files {
+			files[file.Path] = file
+		}
+	}
+
+	return files
+}
+
+// extractFile writes the content of the entry to the target file and verifies its checksum.
+func extractFile(r io.Reader, target string, file File) error {
+	if err := os.MkdirAll(filepath.Dir(target), dirPermissions); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %w", target, err)
+	}
+
+	out, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, filePermissions)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", target, err)
+	}
+	defer out.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(out, h), r); err != nil {
+		return fmt.Errorf("failed to extract %s: %w", file.Path, err)
+	}
+
+	if sum := hex.EncodeToString(h.Sum(nil)); sum != file.SHA256 {
+		_ = os.Remove(target)
+
+		return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", file.Path, file.SHA256, sum)
+	}
+
+	return nil
+}
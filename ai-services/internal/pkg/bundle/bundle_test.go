@@ -0,0 +1,154 @@
+package bundle
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+const testModel = "ibm-granite/granite-test"
+
+// writeBundle writes a bundle archive holding the manifest followed by the entries, in the given order.
+func writeBundle(t *testing.T, manifest Manifest, entries ...[2]string) string {
+	t.Helper()
+
+	manifest.Version, manifest.Arch = Version, runtime.GOARCH
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bundlePath := filepath.Join(t.TempDir(), "bundle.tar")
+	f, err := os.Create(bundlePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	tw := tar.NewWriter(f)
+	for _, entry := range append([][2]string{{manifestFile, string(data)}}, entries...) {
+		if err := tw.WriteHeader(&tar.Header{Name: entry[0], Mode: filePermissions, Size: int64(len(entry[1]))}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(entry[1])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return bundlePath
+}
+
+// modelFile returns the manifest entry of the file of the test model holding the content.
+func modelFile(name, content string) File {
+	sum := sha256.Sum256([]byte(content))
+
+	return File{Path: "models/" + testModel + "/" + name, Size: int64(len(content)), SHA256: hex.EncodeToString(sum[:])}
+}
+
+func TestInstallModels(t *testing.T) {
+	config, weights := modelFile("config.json", "{}"), modelFile("model.safetensors", "weights")
+	bundlePath := writeBundle(t, Manifest{Models: []Model{{Name: testModel, Files: []File{config, weights}}}},
+		[2]string{config.Path, "{}"}, [2]string{weights.Path, "weights"})
+
+	b, err := Open(bundlePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	modelDir := t.TempDir()
+	if err := b.InstallModels(modelDir); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(modelDir, testModel, "model.safetensors"))
+	if err != nil || string(got) != "weights" {
+		t.Errorf("expected the weights to be installed, got %q: %v", got, err)
+	}
+}
+
+func TestInstallModelsChecksumMismatch(t *testing.T) {
+	weights := modelFile("model.safetensors", "weights")
+	bundlePath := writeBundle(t, Manifest{Models: []Model{{Name: testModel, Files: []File{weights}}}},
+		[2]string{weights.Path, "tampered"})
+
+	b, err := Open(bundlePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	modelDir := t.TempDir()
+	err = b.InstallModels(modelDir)
+	if err == nil || !strings.Contains(err.Error(), "checksum mismatch for "+weights.Path) {
+		t.Fatalf("expected a checksum mismatch, got: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(modelDir, testModel, "model.safetensors")); !os.IsNotExist(err) {
+		t.Errorf("expected the corrupted file to be removed, got: %v", err)
+	}
+}
+
+func TestInstallModelsIncomplete(t *testing.T) {
+	config, weights := modelFile("config.json", "{}"), modelFile("model.safetensors", "weights")
+	bundlePath := writeBundle(t, Manifest{Models: []Model{{Name: testModel, Files: []File{config, weights}}}},
+		[2]string{config.Path, "{}"})
+
+	b, err := Open(bundlePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.InstallModels(t.TempDir()); err == nil || !strings.Contains(err.Error(), "1 file(s) listed in the manifest are missing") {
+		t.Fatalf("expected the bundle to be incomplete, got: %v", err)
+	}
+}
+
+func TestOpenRejectsEscapingPaths(t *testing.T) {
+	escaping := modelFile("config.json", "{}")
+	escaping.Path = "models/" + testModel + "/../../../../etc/cron.d/evil"
+
+	manifests := map[string]Manifest{
+		"model name":            {Models: []Model{{Name: "../../etc"}}},
+		"absolute name":         {Models: []Model{{Name: "/etc"}}},
+		"model file":            {Models: []Model{{Name: testModel, Files: []File{escaping}}}},
+		"file of another model": {Models: []Model{{Name: "ibm-granite/other", Files: []File{modelFile("config.json", "{}")}}}},
+		"package":               {Packages: []File{{Path: "../podman.rpm"}}},
+		"image":                 {Images: []Image{{Name: "ubi", File: File{Path: "/tmp/ubi.tar"}}}},
+	}
+
+	for name, manifest := range manifests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Open(writeBundle(t, manifest)); err == nil || !strings.Contains(err.Error(), "is not a valid bundle") {
+				t.Fatalf("expected the bundle to be rejected, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestInstallModelsIgnoresUnlistedEntries(t *testing.T) {
+	weights := modelFile("model.safetensors", "weights")
+	bundlePath := writeBundle(t, Manifest{Models: []Model{{Name: testModel, Files: []File{weights}}}},
+		[2]string{"../../evil", "evil"}, [2]string{weights.Path, "weights"})
+
+	b, err := Open(bundlePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := t.TempDir()
+	modelDir := filepath.Join(root, "models")
+	if err := b.InstallModels(modelDir); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Name() != "models" {
+		t.Errorf("expected only the model directory to be written, got %v", entries)
+	}
+}
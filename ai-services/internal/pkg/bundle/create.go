@@ -0,0 +1,270 @@
+package bundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/image"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/modelstore"
+	airuntime "github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// Packages are the RPMs bundled to install on the air-gapped host along with their dependencies.
+var Packages = []string{"podman"}
+
+// CreateOptions holds the options to create a bundle.
+type CreateOptions struct {
+	Template string
+	// Output is the path of the bundle archive to create
+	Output string
+}
+
+// source is a file to add to the bundle archive.
+type source struct {
+	file File
+	path string
+}
+
+// Create creates a bundle archive with the RPMs, the container images and the models required by the template.
+// The images and the models not present locally are downloaded first.
+func Create(ctx context.Context, h *host.Host, rt airuntime.Runtime, opts CreateOptions) error {
+	workDir, err := os.MkdirTemp(filepath.Dir(opts.Output), ".ai-services-bundle-*")
+	if err != nil {
+		return fmt.Errorf("failed to create working directory: %w", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	manifest := Manifest{Version: Version, Template: opts.Template, Arch: runtime.GOARCH, CreatedAt: time.Now()}
+	var sources []source
+
+	logger.Infoln("Downloading the packages: " + strings.Join(Packages, ", "))
+	pkgSources, err := downloadPackages(ctx, h.Runner, filepath.Join(workDir, packagesDir))
+	if err != nil {
+		return err
+	}
+	for _, src := range pkgSources {
+		manifest.Packages = append(manifest.Packages, src.file)
+	}
+	sources = append(sources, pkgSources...)
+
+	// the template name is used as the application name to render the pod templates
+	imgSources, err := saveImages(ctx, h.Runner, rt, opts.Template, filepath.Join(workDir, imagesDir), &manifest)
+	if err != nil {
+		return err
+	}
+	sources = append(sources, imgSources...)
+
+	modelSources, err := collectModels(ctx, opts.Template, &manifest)
+	if err != nil {
+		return err
+	}
+	sources = append(sources, modelSources...)
+
+	logger.Infof("Writing the bundle %s\n", opts.Output)
+
+	return writeArchive(opts.Output, manifest, sources)
+}
+
+// downloadPackages downloads the RPMs of the packages along with all their dependencies.
+func downloadPackages(ctx context.Context, runner host.CommandRunner, dir string) ([]source, error) {
+	args := append([]string{"download", "--resolve", "--alldeps", "--destdir", dir}, Packages...)
+	if out, err := runner.Run(ctx, "dnf", args...); err != nil {
+		return nil, fmt.Errorf("failed to download packages: %v, output: %s", err, string(out))
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read downloaded packages: %w", err)
+	}
+
+	sources := make([]source, 0, len(entries))
+	for _, entry := range entries {
+		if filepath.Ext(entry.Name()) != ".rpm" {
+			continue
+		}
+		src, err := newSource(filepath.Join(dir, entry.Name()), path.Join(packagesDir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, src)
+	}
+
+	return sources, nil
+}
+
+// saveImages pulls the images of the template not present locally and saves them as archives.
+func saveImages(ctx context.Context, runner host.CommandRunner, rt airuntime.Runtime, template, dir string, manifest *Manifest) ([]source, error) {
+	images, err := image.ListImages(template, template)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list container images: %w", err)
+	}
+
+	if err := image.NewImagePull(rt, image.PullIfNotPresent, template, template).Run(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(dir, dirPermissions); err != nil {
+		return nil, fmt.Errorf("failed to create images directory: %w", err)
+	}
+
+	sources := make([]source, 0, len(images))
+	for i, img := range images {
+		logger.Infoln("Saving image: " + img)
+		name := strconv.Itoa(i) + ".tar"
+		archive := filepath.Join(dir, name)
+		if out, err := runner.Run(ctx, "podman", "save", "-o", archive, img); err != nil {
+			return nil, fmt.Errorf("failed to save image %s: %v, output: %s", img, err, string(out))
+		}
+
+		src, err := newSource(archive, path.Join(imagesDir, name))
+		if err != nil {
+			return nil, err
+		}
+		manifest.Images = append(manifest.Images, Image{Name: img, File: src.file})
+		sources = append(sources, src)
+	}
+
+	return sources, nil
+}
+
+// collectModels downloads the models of the template not present locally and lists their files.
+func collectModels(ctx context.Context, template string, manifest *Manifest) ([]source, error) {
+	models, err := helpers.ListModels(template, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list models: %w", err)
+	}
+
+	var sources []source
+	for _, model := range models {
+		modelDir := filepath.Join(vars.ModelDirectory, model.Repo)
+		if !modelstore.IsDownloaded(modelDir, model) {
+			if err := helpers.DownloadModel(ctx, model, vars.ModelDirectory, nil); err != nil {
+				return nil, fmt.Errorf("failed to download model: %w", err)
+			}
+		}
+
+		bundled := Model{Name: model.Repo, Commit: modelstore.Commit(modelDir)}
+		err := filepath.WalkDir(modelDir, func(p string, d fs.DirEntry, err error) error {
+			if err != nil || !d.Type().IsRegular() {
+				return err
+			}
+			rel, err := filepath.Rel(modelDir, p)
+			if err != nil {
+				return err
+			}
+			src, err := newSource(p, path.Join(modelsDir, model.Repo, filepath.ToSlash(rel)))
+			if err != nil {
+				return err
+			}
+			bundled.Files = append(bundled.Files, src.file)
+			sources = append(sources, src)
+
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read model %s: %w", model, err)
+		}
+		manifest.Models = append(manifest.Models, bundled)
+	}
+
+	return sources, nil
+}
+
+// newSource returns the file to add to the archive at the given path along with its checksum.
+func newSource(filePath, archivePath string) (source, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return source{}, fmt.Errorf("failed to open %s: %w", filePath, err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return source{}, fmt.Errorf("failed to read %s: %w", filePath, err)
+	}
+
+	return source{file: File{Path: archivePath, Size: size, SHA256: hex.EncodeToString(h.Sum(nil))}, path: filePath}, nil
+}
+
+// writeArchive writes the manifest followed by the files to the archive. The archive is written to a temporary file
+// first so that an incomplete bundle is never left behind.
+func writeArchive(output string, manifest Manifest, sources []source) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode bundle manifest: %w", err)
+	}
+
+	tmp := output + ".partial"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return fmt.Errorf("failed to create bundle: %w", err)
+	}
+	defer os.Remove(tmp)
+	defer f.Close()
+
+	tw := tar.NewWriter(f)
+	if err := writeEntry(tw, manifestFile, int64(len(data)), bytes.NewReader(data)); err != nil {
+		return err
+	}
+
+	for _, src := range sources {
+		if err := addFile(tw, src); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to write bundle: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write bundle: %w", err)
+	}
+
+	if err := os.Rename(tmp, output); err != nil {
+		return fmt.Errorf("failed to create bundle: %w", err)
+	}
+
+	return nil
+}
+
+func addFile(tw *tar.Writer, src source) error {
+	f, err := os.Open(src.path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", src.path, err)
+	}
+	defer f.Close()
+
+	return writeEntry(tw, src.file.Path, src.file.Size, f)
+}
+
+func writeEntry(tw *tar.Writer, name string, size int64, r io.Reader) error {
+	hdr := &tar.Header{Name: name, Mode: filePermissions, Size: size, ModTime: time.Now(), Typeflag: tar.TypeReg}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return fmt.Errorf("failed to write %s to the bundle: %w", name, err)
+	}
+
+	if _, err := io.Copy(tw, r); err != nil {
+		return fmt.Errorf("failed to write %s to the bundle: %w", name, err)
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package helpers
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -11,16 +13,19 @@ import (
 	"github.com/containers/podman/v5/libpod/define"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
 const (
 	inspectPollInterval = 10 * time.Second
+	reportTimeout       = 30 * time.Second
 )
 
-func WaitForContainerReadiness(runtime runtime.Runtime, containerNameOrId string, timeout time.Duration) error {
+func WaitForContainerReadiness(ctx context.Context, runtime runtime.Runtime, containerNameOrId string, timeout time.Duration) error {
 	var containerStatus *define.InspectContainerData
 	var err error
 
@@ -28,7 +33,7 @@ func WaitForContainerReadiness(runtime runtime.Runtime, containerNameOrId string
 
 	for {
 		// fetch the container status
-		containerStatus, err = runtime.InspectContainer(containerNameOrId)
+		containerStatus, err = runtime.InspectContainer(ctx, containerNameOrId)
 		if err != nil {
 			return fmt.Errorf("failed to check container status: %w", err)
 		}
@@ -49,17 +54,19 @@ func WaitForContainerReadiness(runtime runtime.Runtime, containerNameOrId string
 		}
 
 		// every 10 seconds inspect the container
-		time.Sleep(inspectPollInterval)
+		if err := sleepWithContext(ctx, inspectPollInterval); err != nil {
+			return fmt.Errorf("stopped waiting for container readiness: %w", err)
+		}
 	}
 }
 
 // WaitForContainersCreation waits until all the containers in the provided podID are created within the specified timeout.
-func WaitForContainersCreation(runtime runtime.Runtime, podID string, expectedContainerCount int, timeout time.Duration) error {
+func WaitForContainersCreation(ctx context.Context, runtime runtime.Runtime, podID string, expectedContainerCount int, timeout time.Duration) error {
 	deadline := time.Now().Add(timeout)
 
 	for {
 		// fetch the pod info
-		pInfo, err := runtime.InspectPod(podID)
+		pInfo, err := runtime.InspectPod(ctx, podID)
 		if err != nil {
 			return fmt.Errorf("failed to do pod inspect for podID: %s with error: %w", podID, err)
 		}
@@ -76,13 +83,25 @@ func WaitForContainersCreation(runtime runtime.Runtime, podID string, expectedCo
 		}
 
 		// every 10 seconds inspect the pod
-		time.Sleep(inspectPollInterval)
+		if err := sleepWithContext(ctx, inspectPollInterval); err != nil {
+			return fmt.Errorf("stopped waiting for container creation: %w", err)
+		}
 	}
 }
 
-func FetchContainerStartPeriod(runtime runtime.Runtime, containerNameOrId string) (time.Duration, error) {
+// sleepWithContext sleeps for the given duration or until the context is done.
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
+
+func FetchContainerStartPeriod(ctx context.Context, runtime runtime.Runtime, containerNameOrId string) (time.Duration, error) {
 	// fetch the container stats
-	containerStats, err := runtime.InspectContainer(containerNameOrId)
+	containerStats, err := runtime.InspectContainer(ctx, containerNameOrId)
 	if err != nil {
 		return 0, fmt.Errorf("failed to check container stats: %w", err)
 	}
@@ -97,10 +116,10 @@ func FetchContainerStartPeriod(runtime runtime.Runtime, containerNameOrId string
 	return healthCheck.StartPeriod, nil
 }
 
-func ListSpyreCards() ([]string, error) {
+// ListSpyreCards returns the PCI addresses of the Spyre cards attached to the host.
+func ListSpyreCards(ctx context.Context, runner host.CommandRunner) ([]string, error) {
 	spyre_device_ids_list := []string{}
-	cmd := exec.Command("lspci", "-d", "1014:06a7")
-	out, err := cmd.CombinedOutput()
+	out, err := runner.Run(ctx, "lspci", "-d", "1014:06a7")
 	if err != nil {
 		return spyre_device_ids_list, fmt.Errorf("failed to get PCI devices attached to lpar: %v, output: %s", err, string(out))
 	}
@@ -122,6 +141,34 @@ func ListSpyreCards() ([]string, error) {
 	return spyre_device_ids_list, nil
 }
 
+// SpyreCardDrivers returns the kernel driver in use by each Spyre card keyed by its PCI address.
+// The driver is empty if the card is not bound to any driver.
+func SpyreCardDrivers(ctx context.Context, runner host.CommandRunner) (map[string]string, error) {
+	out, err := runner.Run(ctx, "lspci", "-k", "-d", "1014:06a7")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kernel drivers of the PCI devices: %v, output: %s", err, string(out))
+	}
+
+	drivers := map[string]string{}
+	card := ""
+	for _, line := range strings.Split(string(out), "\n") {
+		switch {
+		case line == "":
+			continue
+		case !strings.HasPrefix(line, "\t") && !strings.HasPrefix(line, " "):
+			// Eg:- 0381:50:00.0 Processing accelerators: IBM Device 06a7 (rev 02)
+			card = strings.Fields(line)[0]
+			drivers[card] = ""
+		case card != "":
+			if driver, ok := strings.CutPrefix(strings.TrimSpace(line), "Kernel driver in use:"); ok {
+				drivers[card] = strings.TrimSpace(driver)
+			}
+		}
+	}
+
+	return drivers, nil
+}
+
 func FindFreeSpyreCards() ([]string, error) {
 	free_spyre_dev_id_list := []string{}
 	dev_files, err := os.ReadDir("/dev/vfio")
@@ -159,12 +206,42 @@ func FindFreeSpyreCards() ([]string, error) {
 	return free_spyre_dev_id_list, nil
 }
 
-func RunServiceReportContainer(runCmd string, mode string) error {
-	var svc_tool_cmd *exec.Cmd
+// RunServiceReportContainer runs the ServiceReport tool container on the host in the given mode: configure or validate.
+// The output of the tool is captured, not to garble the progress rendered by the caller, and returned in the error on failure.
+func RunServiceReportContainer(ctx context.Context, runner host.CommandRunner, runCmd string, mode string) error {
+	out, err := ServiceReportOutput(ctx, runner, runCmd, mode)
+	if err == nil {
+		return nil
+	}
+	if out = bytes.TrimSpace(out); len(out) > 0 {
+		return fmt.Errorf("%w, output:\n%s", err, out)
+	}
+
+	return err
+}
+
+// ServiceReportOutput runs the ServiceReport tool container on the host in the given mode and returns its output.
+// The output is returned on failure as well, as it explains the failure.
+func ServiceReportOutput(ctx context.Context, runner host.CommandRunner, runCmd string, mode string) ([]byte, error) {
+	args, err := serviceReportArgs(runCmd, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := runner.Run(ctx, "podman", args...)
+	if err != nil {
+		return out, fmt.Errorf("failed to run servicereport tool: %v", err)
+	}
+
+	return out, nil
+}
+
+// serviceReportArgs returns the podman arguments to run the ServiceReport tool container in the given mode.
+func serviceReportArgs(runCmd string, mode string) ([]string, error) {
+	var args []string
 	switch mode {
 	case "configure":
-		svc_tool_cmd = exec.Command(
-			"podman",
+		args = []string{
 			"run",
 			"--privileged",
 			"--rm",
@@ -176,10 +253,9 @@ func RunServiceReportContainer(runCmd string, mode string) error {
 			"-v", "/etc/sos:/etc/sos",
 			vars.ToolImage,
 			"bash", "-c", runCmd,
-		)
+		}
 	case "validate":
-		svc_tool_cmd = exec.Command(
-			"podman",
+		args = []string{
 			"run",
 			"--privileged",
 			"--rm",
@@ -192,19 +268,12 @@ func RunServiceReportContainer(runCmd string, mode string) error {
 			"-v", "/etc/sos:/etc/sos:ro",
 			vars.ToolImage,
 			"bash", "-c", runCmd,
-		)
+		}
 	default:
-		return fmt.Errorf("invalid mode passed. Allowed options are configure, validate")
+		return nil, fmt.Errorf("invalid mode passed. Allowed options are configure, validate")
 	}
 
-	svc_tool_cmd.Stdout = os.Stdout
-	svc_tool_cmd.Stderr = os.Stderr
-
-	if err := svc_tool_cmd.Run(); err != nil {
-		return fmt.Errorf("failed to run servicereport tool to validate Spyre cards configuration: %v", err)
-	}
-
-	return nil
+	return args, nil
 }
 
 func ParseSkipChecks(skipChecks []string) map[string]bool {
@@ -223,10 +292,10 @@ func ParseSkipChecks(skipChecks []string) map[string]bool {
 }
 
 // CheckExistingPodsForApplication checks if there are pods already existing for the given application name.
-func CheckExistingPodsForApplication(runtime runtime.Runtime, appName string) ([]string, error) {
+func CheckExistingPodsForApplication(ctx context.Context, runtime runtime.Runtime, appName string) ([]string, error) {
 	//nolint:prealloc // as capacity is unknown and depends on runtime.ListPods response
 	var podsToSkip []string
-	pods, err := runtime.ListPods(map[string][]string{
+	pods, err := runtime.ListPods(ctx, map[string][]string{
 		"label": {fmt.Sprintf("ai-services.io/application=%s", appName)},
 	})
 	if err != nil {
@@ -247,3 +316,37 @@ func CheckExistingPodsForApplication(runtime runtime.Runtime, appName string) ([
 
 	return podsToSkip, nil
 }
+
+// ReportInterruptedPods reports the state in which the pods of the given application were left
+// after the operation was interrupted (Ctrl+C, SIGTERM or --timeout).
+func ReportInterruptedPods(runtime runtime.Runtime, appName string, cause error) {
+	logger.Warningf("Operation on application '%s' interrupted: %v\n", appName, cause)
+
+	// the operation context is already done, hence use a fresh one to fetch the pod states
+	ctx, cancel := context.WithTimeout(context.Background(), reportTimeout)
+	defer cancel()
+
+	pods, err := runtime.ListPods(ctx, map[string][]string{
+		"label": {fmt.Sprintf("ai-services.io/application=%s", appName)},
+	})
+	if err != nil {
+		logger.Errorf("failed to fetch the pods state for application '%s': %v\n", appName, err)
+
+		return
+	}
+
+	if len(pods) == 0 {
+		logger.Infof("No pods were left behind for application: %s\n", appName)
+
+		return
+	}
+
+	logger.Infoln("Application pods were left in below state:")
+	p := utils.NewTableWriter()
+	defer p.CloseTableWriter()
+
+	p.SetHeaders("POD NAME", "STATUS")
+	for _, pod := range pods {
+		p.AppendRow(pod.Name, pod.Status)
+	}
+}
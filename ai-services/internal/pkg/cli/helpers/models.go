@@ -1,19 +1,67 @@
 package helpers
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
-	"os/exec"
+	"path/filepath"
 	"strings"
 
+	"github.com/docker/go-units"
+	"sigs.k8s.io/yaml"
+
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/hf"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/models"
-	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+	"github.com/project-ai-services/ai-services/internal/pkg/modelstore"
+)
+
+const (
+	// percentScale scales the ratio of the bytes downloaded into a percentage.
+	percentScale = 100
+	// spyreCardMemory is the device memory of a Spyre card, holding the weights of the model it serves.
+	spyreCardMemory = 128 * units.GiB
+	// modelConfigFile is the configuration of the model, declaring the context it supports.
+	modelConfigFile = "config.json"
 )
 
-func ListModels(template, appName string) ([]string, error) {
+// weightSuffixes are the suffixes of the files holding the weights of a model, by preference as a model may ship its
+// weights in both formats while vLLM only loads one.
+var weightSuffixes = []string{".safetensors", ".bin"}
+
+// ListModels returns the models of the application, configured by the values the application was deployed with, or
+// by the default values of the template if the application is not deployed yet or if appName is empty.
+func ListModels(template, appName string) ([]hf.Ref, error) {
+	values, err := LoadAppValues(template, appName)
+	if err != nil {
+		return nil, err
+	}
+
+	return ListModelsWithValues(template, appName, values)
+}
+
+// ListModelsWithValues returns the models configured under models in the values, Eg:- models.instruct.id, or else the
+// models referenced by the model annotations of the pod templates rendered with the values, Eg:- repo@commit to pin
+// the revision. A repository referenced with different revisions is an error as a single revision is kept on disk.
+func ListModelsWithValues(template, appName string, values map[string]any) ([]hf.Ref, error) {
+	configured, err := templates.ParseModels(values)
+	if err != nil {
+		return nil, fmt.Errorf("invalid models in the values of %s: %w", template, err)
+	}
+	if configured != nil {
+		refs := make([]hf.Ref, 0, len(configured))
+		for _, model := range configured {
+			refs = append(refs, model.Ref())
+		}
+
+		return uniqueRefs(refs)
+	}
+
 	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
 	tmpls, err := tp.LoadAllTemplates(template)
 	if err != nil {
@@ -31,50 +79,197 @@ func ListModels(template, appName string) ([]string, error) {
 		return modelAnnotations
 	}
 
-	modelList := []string{}
+	params := map[string]any{
+		"Values":          values,
+		"AppName":         appName,
+		"AppTemplateName": "",
+		"Version":         "",
+	}
+	modelList := []hf.Ref{}
 	for _, tmpl := range tmpls {
-		ps, err := tp.LoadPodTemplateWithValues(template, tmpl.Name(), appName, nil, nil)
+		ps, err := tp.LoadPodTemplate(template, tmpl.Name(), params)
 		if err != nil {
 			return nil, fmt.Errorf("error loading pod template: %w", err)
 		}
-		modelList = append(modelList, models(*ps)...)
+		for _, value := range models(*ps) {
+			ref, err := hf.ParseRef(value)
+			if err != nil {
+				return nil, fmt.Errorf("error in pod template %s: %w", tmpl.Name(), err)
+			}
+			modelList = append(modelList, ref)
+		}
 	}
 
-	return modelList, nil
+	return uniqueRefs(modelList)
 }
 
-func DownloadModel(model, targetDir string) error {
-	// check for target model directory, if not present create it
-	if _, err := os.Stat(targetDir); os.IsNotExist(err) {
-		err := os.MkdirAll(targetDir, os.ModePerm)
-		if err != nil {
-			return fmt.Errorf("failed to create target model directory: %w", err)
+// LoadAppValues returns the values the application was deployed with, or the default values of the template if the
+// application is not deployed yet or if appName is empty.
+func LoadAppValues(template, appName string) (map[string]any, error) {
+	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	defaults, err := tp.LoadValues(template, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the values of %s: %w", template, err)
+	}
+	if appName == "" {
+		return defaults, nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(constants.ApplicationsPath, appName, constants.ValuesFile))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return defaults, nil
 		}
+
+		return nil, fmt.Errorf("failed to read the values of application '%s': %w", appName, err)
+	}
+
+	values := map[string]any{}
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("failed to parse the values of application '%s': %w", appName, err)
 	}
-	logger.Infof("Downloading model %s to %s\n", model, targetDir)
-	command := "podman"
-	// All arguments must be passed as a slice of strings
-	args := []string{
-		"run",
-		"-ti",
-		"-v",
-		fmt.Sprintf("%s:/models:Z", targetDir),
-		vars.ToolImage,
-		"hf",
-		"download",
-		model,
-		"--local-dir",
-		fmt.Sprintf("/models/%s", model),
-	}
-	cmd := exec.Command(command, args...)
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	cmd.Stdin = os.Stdin
-	err := cmd.Run()
+	// the applications deployed before the models were configured through the values serve the default models
+	if _, ok := values[templates.ModelsKey]; !ok {
+		if models, ok := defaults[templates.ModelsKey]; ok {
+			values[templates.ModelsKey] = models
+		}
+	}
+
+	return values, nil
+}
+
+// uniqueRefs removes the duplicated references, failing if a repository is referenced with different revisions.
+func uniqueRefs(refs []hf.Ref) ([]hf.Ref, error) {
+	unique := make([]hf.Ref, 0, len(refs))
+	seen := map[string]hf.Ref{}
+	for _, ref := range refs {
+		if existing, ok := seen[ref.Repo]; ok {
+			if existing.RevisionOrDefault() != ref.RevisionOrDefault() {
+				return nil, fmt.Errorf("model %s is referenced with different revisions: %s and %s", ref.Repo, existing.RevisionOrDefault(), ref.RevisionOrDefault())
+			}
+
+			continue
+		}
+		seen[ref.Repo] = ref
+		unique = append(unique, ref)
+	}
+
+	return unique, nil
+}
+
+// DownloadModel downloads the revision of the model from the Hugging Face Hub into targetDir/<model>, resuming a
+// previous incomplete download, and records the commit downloaded. The progress is reported if set.
+func DownloadModel(ctx context.Context, model hf.Ref, targetDir string, progress hf.Progress) error {
+	modelDir := filepath.Join(targetDir, model.Repo)
+	logger.Infof("Downloading model %s to %s\n", model, modelDir)
+
+	info, err := hf.NewClient().Download(ctx, model.Repo, model.RevisionOrDefault(), modelDir, progress)
 	if err != nil {
-		return fmt.Errorf("failed to execute command: %w", err)
+		return fmt.Errorf("failed to download model %s: %w", model, err)
 	}
-	logger.Infoln("Model downloaded successfully")
+	if err := modelstore.WriteManifest(modelDir, model.Repo, model.RevisionOrDefault(), info); err != nil {
+		return err
+	}
+	logger.Infof("Model %s downloaded successfully at commit %s\n", model.Repo, info.SHA, logger.VerbosityLevelDebug)
 
 	return nil
 }
+
+// MarkModelsUsed records the models as used by an application now, to tell the models no longer used apart.
+// The failures are only logged as the application does not depend on them.
+func MarkModelsUsed(models []hf.Ref, targetDir string) {
+	for _, model := range models {
+		if err := modelstore.MarkUsed(filepath.Join(targetDir, model.Repo)); err != nil {
+			logger.Infof("failed to mark model %s used: %v\n", model, err, logger.VerbosityLevelDebug)
+		}
+	}
+}
+
+// ModelDownloadProgress returns the progress of the model download calling update with the message to display,
+// Eg:- the message of a spinner.
+func ModelDownloadProgress(model string, update func(message string)) hf.Progress {
+	return func(done, total int64) {
+		percent := 0
+		if total > 0 {
+			percent = int(done * percentScale / total)
+		}
+		update(fmt.Sprintf("Downloading model: %s... %s / %s (%d%%)", model, units.HumanSize(float64(done)), units.HumanSize(float64(total)), percent))
+	}
+}
+
+// ValidateModelFit verifies the model fits the Spyre cards requested for it, that is its weights fit in the memory of
+// the cards, and that the maximum context requested does not exceed the context the model supports.
+// The model is looked up on the hub, hence it is verified before being downloaded.
+func ValidateModelFit(ctx context.Context, client *hf.Client, model templates.Model) error {
+	if model.Cards == 0 && model.MaxModelLen == 0 {
+		return nil
+	}
+
+	info, err := client.Revision(ctx, model.ID, model.Revision)
+	if err != nil {
+		return fmt.Errorf("failed to look up model %s: %w", model.ID, err)
+	}
+
+	if model.MaxModelLen > 0 {
+		if err := validateMaxModelLen(ctx, client, model, info.SHA); err != nil {
+			return err
+		}
+	}
+
+	if model.Cards > 0 {
+		weights := weightsSize(info.Files)
+		if available := int64(model.Cards) * spyreCardMemory; weights > available {
+			return fmt.Errorf("model %s does not fit in %d Spyre card(s): its weights need %s, more than the %s available, increase %s.%s.cards",
+				model.ID, model.Cards, units.BytesSize(float64(weights)), units.BytesSize(float64(available)), templates.ModelsKey, model.Role)
+		}
+	}
+
+	return nil
+}
+
+// validateMaxModelLen verifies the maximum context requested does not exceed the context declared by the
+// configuration of the model at the commit. Models without a configuration are not verified.
+func validateMaxModelLen(ctx context.Context, client *hf.Client, model templates.Model, commit string) error {
+	config, err := client.ReadFile(ctx, model.ID, commit, modelConfigFile)
+	if errors.Is(err, hf.ErrNotFound) {
+		logger.Infof("skipping the validation of the context of model %s as it has no %s\n", model.ID, modelConfigFile, logger.VerbosityLevelDebug)
+
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to look up the configuration of model %s: %w", model.ID, err)
+	}
+
+	var cfg struct {
+		MaxPositionEmbeddings int `json:"max_position_embeddings"`
+	}
+	if err := json.Unmarshal(config, &cfg); err != nil {
+		return fmt.Errorf("failed to parse the configuration of model %s: %w", model.ID, err)
+	}
+	if cfg.MaxPositionEmbeddings > 0 && model.MaxModelLen > cfg.MaxPositionEmbeddings {
+		return fmt.Errorf("%s.%s.maxModelLen %d exceeds the %d tokens model %s supports",
+			templates.ModelsKey, model.Role, model.MaxModelLen, cfg.MaxPositionEmbeddings, model.ID)
+	}
+
+	return nil
+}
+
+// weightsSize returns the size of the weights among the files of the model, in the preferred format it ships them in.
+func weightsSize(files []hf.File) int64 {
+	sizes := map[string]int64{}
+	for _, f := range files {
+		for _, suffix := range weightSuffixes {
+			if strings.HasSuffix(f.Path, suffix) {
+				sizes[suffix] += f.Size
+			}
+		}
+	}
+
+	for _, suffix := range weightSuffixes {
+		if sizes[suffix] > 0 {
+			return sizes[suffix]
+		}
+	}
+
+	return 0
+}
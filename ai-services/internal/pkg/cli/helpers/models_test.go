@@ -0,0 +1,86 @@
+package helpers
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/hf"
+	"github.com/project-ai-services/ai-services/internal/pkg/hf/hftest"
+)
+
+const gib = int64(1) << 30
+
+// testHubClient returns a client of the hub listing the weights of the test model with the given sizes, along with its
+// config.json if set.
+func testHubClient(t *testing.T, weights map[string]int64, config string) *hf.Client {
+	t.Helper()
+
+	hub := &hftest.Hub{Sizes: weights}
+	if config != "" {
+		hub.Files = map[string][]byte{"config.json": []byte(config)}
+	}
+
+	return &hf.Client{Endpoint: hub.Start(t), HTTPClient: &http.Client{}}
+}
+
+func TestValidateModelFit(t *testing.T) {
+	client := testHubClient(t, map[string]int64{"model-00001.safetensors": 60 * gib, "model-00002.safetensors": 60 * gib},
+		`{"max_position_embeddings": 131072}`)
+
+	for _, model := range []templates.Model{
+		{Role: "instruct", ID: hftest.Repo, Cards: 1},
+		{Role: "instruct", ID: hftest.Repo, Cards: 1, MaxModelLen: 131072},
+		// the cards are not known, hence only the context is validated
+		{Role: "instruct", ID: hftest.Repo, MaxModelLen: 4096},
+	} {
+		if err := ValidateModelFit(t.Context(), client, model); err != nil {
+			t.Errorf("expected %+v to fit, failed: %v", model, err)
+		}
+	}
+}
+
+func TestValidateModelFitWeights(t *testing.T) {
+	model := templates.Model{Role: "instruct", ID: hftest.Repo, Cards: 1}
+
+	// the safetensors weights are loaded in place of the bin weights, when both are published
+	client := testHubClient(t, map[string]int64{"model.safetensors": 100 * gib, "pytorch_model.bin": 200 * gib}, "")
+	if err := ValidateModelFit(t.Context(), client, model); err != nil {
+		t.Errorf("expected the safetensors weights to fit, failed: %v", err)
+	}
+
+	for name, weights := range map[string]map[string]int64{
+		"safetensors": {"model-00001.safetensors": 100 * gib, "model-00002.safetensors": 100 * gib},
+		"bin":         {"pytorch_model.bin": 200 * gib},
+	} {
+		client := testHubClient(t, weights, "")
+		if err := ValidateModelFit(t.Context(), client, model); err == nil || !strings.Contains(err.Error(), "does not fit in 1 Spyre card(s)") {
+			t.Errorf("expected the %s weights not to fit, got: %v", name, err)
+		}
+	}
+}
+
+func TestValidateModelFitContext(t *testing.T) {
+	model := templates.Model{Role: "instruct", ID: hftest.Repo, MaxModelLen: 8192}
+
+	client := testHubClient(t, nil, `{"max_position_embeddings": 4096}`)
+	err := ValidateModelFit(t.Context(), client, model)
+	if err == nil || !strings.Contains(err.Error(), "models.instruct.maxModelLen 8192 exceeds the 4096 tokens") {
+		t.Errorf("expected the max model len to exceed the context, got: %v", err)
+	}
+
+	// the context of the models without a configuration is not known
+	if err := ValidateModelFit(t.Context(), testHubClient(t, nil, ""), model); err != nil {
+		t.Errorf("expected the model without a configuration to pass, failed: %v", err)
+	}
+}
+
+func TestValidateModelFitUnknownModel(t *testing.T) {
+	model := templates.Model{Role: "instruct", ID: "ibm-granite/missing", Cards: 1}
+
+	err := ValidateModelFit(t.Context(), testHubClient(t, nil, ""), model)
+	if err == nil || !strings.Contains(err.Error(), "failed to look up model ibm-granite/missing") {
+		t.Errorf("expected the model not to be found, got: %v", err)
+	}
+}
@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strings"
 	"text/template"
@@ -20,28 +21,48 @@ const (
 	infoTitle  = "Info"
 )
 
-func PrintNextSteps(runtime runtime.Runtime, app, appTemplate string) error {
+func PrintNextSteps(ctx context.Context, runtime runtime.Runtime, app, appTemplate string) error {
 	params := map[string]string{"AppName": app}
-	if err := renderStepsMarkdown(runtime, appTemplate, params, nextStepsMDFile, nextStepsTitle); err != nil {
+	rendered, err := renderStepsMarkdown(ctx, runtime, appTemplate, params, nextStepsMDFile)
+	if err != nil {
 		logger.Infof("Unable to load steps: %v\n", err)
 
 		return nil
 	}
+	printSteps(nextStepsTitle, rendered)
 
 	return nil
 }
 
-func PrintInfo(runtime runtime.Runtime, app, appTemplate string) error {
-	params := map[string]string{"AppName": app}
-	if err := renderStepsMarkdown(runtime, appTemplate, params, infoMDFile, infoTitle); err != nil {
+func PrintInfo(ctx context.Context, runtime runtime.Runtime, app, appTemplate string) error {
+	rendered, err := RenderInfo(ctx, runtime, app, appTemplate)
+	if err != nil {
 		logger.Infof("Unable to load steps: %v\n", err)
 
 		return nil
 	}
+	printSteps(infoTitle, rendered)
 
 	return nil
 }
 
+// RenderInfo returns the rendered info.md of the application. Returns empty string if the template has no info.md.
+func RenderInfo(ctx context.Context, runtime runtime.Runtime, app, appTemplate string) (string, error) {
+	params := map[string]string{"AppName": app}
+
+	return renderStepsMarkdown(ctx, runtime, appTemplate, params, infoMDFile)
+}
+
+func printSteps(title, rendered string) {
+	if rendered == "" {
+		return
+	}
+
+	logger.Infoln(title + ":")
+	logger.Infoln("-------")
+	logger.Infoln(rendered)
+}
+
 // populatePodValues -> populates the host values within the params.
 func populateHostValues(params map[string]string, varsData *templates.Vars) error {
 	for _, host := range varsData.Hosts {
@@ -58,9 +79,9 @@ func populateHostValues(params map[string]string, varsData *templates.Vars) erro
 	return nil
 }
 
-func populatePodInfo(runtime runtime.Runtime, params map[string]string, varsData *templates.Vars) error {
+func populatePodInfo(ctx context.Context, runtime runtime.Runtime, params map[string]string, varsData *templates.Vars) error {
 	for _, pod := range varsData.Pods {
-		exists, err := runtime.PodExists(pod.Name)
+		exists, err := runtime.PodExists(ctx, pod.Name)
 		if err != nil {
 			return fmt.Errorf("failed to check if pod exists: %w", err)
 		}
@@ -71,7 +92,7 @@ func populatePodInfo(runtime runtime.Runtime, params map[string]string, varsData
 			continue
 		}
 
-		pInfo, err := runtime.InspectPod(pod.Name)
+		pInfo, err := runtime.InspectPod(ctx, pod.Name)
 		if err != nil {
 			return fmt.Errorf("failed to inspect Pod '%s': %w", pod.Name, err)
 		}
@@ -92,9 +113,9 @@ func populatePodInfo(runtime runtime.Runtime, params map[string]string, varsData
 	return nil
 }
 
-func populateContainerInfo(runtime runtime.Runtime, params map[string]string, varsData *templates.Vars) error {
+func populateContainerInfo(ctx context.Context, runtime runtime.Runtime, params map[string]string, varsData *templates.Vars) error {
 	for _, container := range varsData.Containers {
-		exists, err := runtime.ContainerExists(container.Name)
+		exists, err := runtime.ContainerExists(ctx, container.Name)
 		if err != nil {
 			return fmt.Errorf("failed to check if container exists: %w", err)
 		}
@@ -105,7 +126,7 @@ func populateContainerInfo(runtime runtime.Runtime, params map[string]string, va
 			continue
 		}
 
-		cInfo, err := runtime.InspectContainer(container.Name)
+		cInfo, err := runtime.InspectContainer(ctx, container.Name)
 		if err != nil {
 			return fmt.Errorf("failed to inspect Container '%s': %w", container.Name, err)
 		}
@@ -154,48 +175,44 @@ func fetchDataSpecificInfo(data any, format string, defaultValue *string) (strin
 	return strings.TrimSpace(result.String()), nil
 }
 
-func renderStepsMarkdown(runtime runtime.Runtime, appTemplate string, params map[string]string, mdFile, title string) error {
+func renderStepsMarkdown(ctx context.Context, runtime runtime.Runtime, appTemplate string, params map[string]string, mdFile string) (string, error) {
 	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
 	stepsPath := appTemplate + "/steps"
 
 	tmpls, err := tp.LoadMdFiles(stepsPath)
 	if err != nil {
-		return nil
+		return "", nil
 	}
 
 	tmpl, ok := tmpls[mdFile]
 	if !ok {
-		return nil
+		return "", nil
 	}
 
 	varsData, err := tp.LoadVarsFile(appTemplate, params)
 	if err != nil {
-		return fmt.Errorf("failed to load vars file: %w", err)
+		return "", fmt.Errorf("failed to load vars file: %w", err)
 	}
 
 	// populate the host values set in vars file
 	if err := populateHostValues(params, varsData); err != nil {
-		return fmt.Errorf("failed to populate host values: %w", err)
+		return "", fmt.Errorf("failed to populate host values: %w", err)
 	}
 
 	// populate the pod info set in vars file
-	if err := populatePodInfo(runtime, params, varsData); err != nil {
-		return fmt.Errorf("failed to populate pod values: %w", err)
+	if err := populatePodInfo(ctx, runtime, params, varsData); err != nil {
+		return "", fmt.Errorf("failed to populate pod values: %w", err)
 	}
 
 	// populate the container info set in vars file
-	if err := populateContainerInfo(runtime, params, varsData); err != nil {
-		return fmt.Errorf("failed to populate container values: %w", err)
+	if err := populateContainerInfo(ctx, runtime, params, varsData); err != nil {
+		return "", fmt.Errorf("failed to populate container values: %w", err)
 	}
 
 	var rendered bytes.Buffer
 	if err := tmpl.Execute(&rendered, params); err != nil {
-		return fmt.Errorf("failed to execute info.md: %w", err)
+		return "", fmt.Errorf("failed to execute info.md: %w", err)
 	}
 
-	logger.Infoln(title + ":")
-	logger.Infoln("-------")
-	logger.Infoln(rendered.String())
-
-	return nil
+	return rendered.String(), nil
 }
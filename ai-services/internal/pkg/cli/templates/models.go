@@ -0,0 +1,137 @@
+package templates
+
+import (
+	"fmt"
+	"maps"
+	"math/bits"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/hf"
+)
+
+// ModelsKey is the key of the values configuring the models served by the application, Eg:- models.instruct.id.
+const ModelsKey = "models"
+
+// Model is a model served by the application, configured under models.<role> in the values.
+type Model struct {
+	// Role is the role of the model within the application, Eg:- instruct, embedding or reranker
+	Role string `json:"role"`
+	// ID is the Hugging Face repository of the model, Eg:- ibm-granite/granite-3.3-8b-instruct
+	ID string `json:"id"`
+	// Revision pins the model to a commit, a branch or a tag, the default revision if empty
+	Revision string `json:"revision,omitempty"`
+	// Cards is the number of Spyre cards serving the model, 0 if the model is not served on Spyre cards
+	Cards int `json:"cards,omitempty"`
+	// MaxModelLen is the maximum number of tokens in the context of the model, 0 if not configured
+	MaxModelLen int `json:"maxModelLen,omitempty"`
+}
+
+// Ref returns the reference to the revision of the model to download.
+func (m Model) Ref() hf.Ref {
+	return hf.Ref{Repo: m.ID, Revision: m.Revision}
+}
+
+// ParseModels parses and validates the models configured in the values, sorted by their role.
+// Returns nil if the values do not configure any model, Eg:- for the templates still annotating their models.
+func ParseModels(values map[string]any) ([]Model, error) {
+	raw, ok := values[ModelsKey]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	roles, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s: expected the models by their role", ModelsKey)
+	}
+
+	models := make([]Model, 0, len(roles))
+	for _, role := range slices.Sorted(maps.Keys(roles)) {
+		model, err := parseModel(role, roles[role])
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, model)
+	}
+
+	return models, nil
+}
+
+func parseModel(role string, raw any) (Model, error) {
+	key := ModelsKey + "." + role
+	fields, ok := raw.(map[string]any)
+	if !ok {
+		return Model{}, fmt.Errorf("invalid %s: expected the id of the model", key)
+	}
+
+	model := Model{Role: role, ID: stringValue(fields["id"]), Revision: stringValue(fields["revision"])}
+	if model.ID == "" {
+		return Model{}, fmt.Errorf("invalid %s.id: the id of the model is required", key)
+	}
+	if strings.Contains(model.ID, "@") {
+		return Model{}, fmt.Errorf("invalid %s.id '%s': set the revision using %s.revision", key, model.ID, key)
+	}
+	if _, err := hf.ParseRef(model.Ref().String()); err != nil {
+		return Model{}, fmt.Errorf("invalid %s: %w", key, err)
+	}
+
+	var err error
+	if model.Cards, err = positiveInt(fields["cards"]); err != nil {
+		return Model{}, fmt.Errorf("invalid %s.cards: %w", key, err)
+	}
+	// tensor parallelism splits the model evenly across the cards serving it
+	if model.Cards > 0 && bits.OnesCount(uint(model.Cards)) != 1 {
+		return Model{}, fmt.Errorf("invalid %s.cards %d: the model can only be split across a power of two Spyre cards (e.g., 1, 2, 4 or 8)", key, model.Cards)
+	}
+	if model.MaxModelLen, err = positiveInt(fields["maxModelLen"]); err != nil {
+		return Model{}, fmt.Errorf("invalid %s.maxModelLen: %w", key, err)
+	}
+
+	return model, nil
+}
+
+func stringValue(value any) string {
+	if value == nil {
+		return ""
+	}
+
+	return strings.TrimSpace(fmt.Sprint(value))
+}
+
+// positiveInt returns the positive integer value, 0 if not set. The values set through --params are strings and the
+// values stored along with the application are decoded as floats, hence both are accepted.
+func positiveInt(value any) (int, error) {
+	var n int
+	switch v := value.(type) {
+	case nil:
+		return 0, nil
+	case int:
+		n = v
+	case int64:
+		n = int(v)
+	case uint64:
+		n = int(v) //nolint:gosec // the values are small integers
+	case float64:
+		if v != float64(int(v)) {
+			return 0, fmt.Errorf("expected an integer, got %v", v)
+		}
+		n = int(v)
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return 0, nil
+		}
+		parsed, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return 0, fmt.Errorf("expected an integer, got '%s'", v)
+		}
+		n = parsed
+	default:
+		return 0, fmt.Errorf("expected an integer, got %v", v)
+	}
+
+	if n <= 0 {
+		return 0, fmt.Errorf("expected a positive integer, got %d", n)
+	}
+
+	return n, nil
+}
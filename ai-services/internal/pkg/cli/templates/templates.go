@@ -7,12 +7,26 @@ import (
 )
 
 type AppMetadata struct {
-	Name                  string     `yaml:"name,omitempty"`
-	Description           string     `yaml:"description,omitempty"`
-	Hidden                bool       `yaml:"hidden,omitempty"`
-	Version               string     `yaml:"version,omitempty"`
-	SMTLevel              *int       `yaml:"smtLevel,omitempty"`
-	PodTemplateExecutions [][]string `yaml:"podTemplateExecutions"`
+	Name                  string        `yaml:"name,omitempty"`
+	Description           string        `yaml:"description,omitempty"`
+	Hidden                bool          `yaml:"hidden,omitempty"`
+	Version               string        `yaml:"version,omitempty"`
+	SMTLevel              *int          `yaml:"smtLevel,omitempty"`
+	Requirements          *Requirements `yaml:"requirements,omitempty"`
+	PodTemplateExecutions [][]string    `yaml:"podTemplateExecutions"`
+}
+
+// Requirements declares the host requirements of an application template, verified before the application is created.
+// Sizes are resource quantities, Eg:- 50Gi. Memory is derived from the pod resources if not declared.
+type Requirements struct {
+	Memory string `yaml:"memory,omitempty"`
+	// ModelDiskSpace is the free space required under the model directory to download the models
+	ModelDiskSpace string `yaml:"modelDiskSpace,omitempty"`
+	// AppDiskSpace is the free space required under the applications path for the application data
+	AppDiskSpace  string   `yaml:"appDiskSpace,omitempty"`
+	Ports         []int    `yaml:"ports,omitempty"`
+	KernelModules []string `yaml:"kernelModules,omitempty"`
+	PodmanVersion string   `yaml:"podmanVersion,omitempty"`
 }
 
 type Vars struct {
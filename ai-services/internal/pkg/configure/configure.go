@@ -0,0 +1,188 @@
+package configure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/bundle"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+// Kinds of the host changes.
+const (
+	KindPackage = "package"
+	KindFile    = "file"
+	KindModule  = "module"
+	KindGroup   = "group"
+	KindService = "service"
+	KindCommand = "command"
+	KindImage   = "image"
+	KindBoolean = "selinux-boolean"
+	// KindCheck is a check of the servicereport tool, only planned as the files it repairs are recorded instead
+	KindCheck = "check"
+)
+
+// Actions of the host changes.
+const (
+	ActionInstall   = "install"
+	ActionStart     = "start"
+	ActionEnable    = "enable"
+	ActionCreate    = "create"
+	ActionModify    = "modify"
+	ActionDelete    = "delete"
+	ActionUpdate    = "update"
+	ActionLoad      = "load"
+	ActionReload    = "reload"
+	ActionAddMember = "add-member"
+	ActionRun       = "run"
+	ActionRepair    = "repair"
+)
+
+// Change is a single change a step makes on the host, Eg:- {package, podman, install}.
+type Change struct {
+	Kind   string `json:"kind"`
+	Target string `json:"target"`
+	Action string `json:"action"`
+	// Detail describes the change further, Eg:- the user added to a group
+	Detail string `json:"detail,omitempty"`
+	// Backup is the copy of a file taken before it was modified or deleted
+	Backup string `json:"backup,omitempty"`
+}
+
+// String returns the action of the change along with its detail.
+func (c Change) String() string {
+	if c.Detail == "" {
+		return c.Action
+	}
+
+	return c.Action + " " + c.Detail
+}
+
+// Step is a discrete configuration step of the LPAR.
+type Step interface {
+	// Name returns the unique name of the step.
+	Name() string
+	// Description returns the message shown while the step runs.
+	Description() string
+	// Check reports whether the host is already configured by the step.
+	// Returns an error if a precondition of the step is not met.
+	Check(ctx context.Context) (bool, error)
+	// Plan returns the changes Apply makes on the host.
+	Plan(ctx context.Context) ([]Change, error)
+	// Apply configures the host, recording each change once it is attempted so that a partially applied step can be
+	// reverted as well.
+	Apply(ctx context.Context, record RecordFunc) error
+}
+
+// RecordFunc records a change attempted on the host along with the error it failed with, nil if applied.
+type RecordFunc func(change Change, err error)
+
+// Steps returns the steps to configure the host in the order they are applied.
+// The packages and the images are installed from the bundle if set, to configure an air-gapped host.
+func Steps(h *host.Host, b *bundle.Bundle) []Step {
+	steps := []Step{
+		&podmanInstallStep{host: h, bundle: b},
+		&podmanSocketStep{host: h},
+		&hostDirsStep{host: h},
+		&vfioModuleStep{host: h},
+	}
+	if b != nil {
+		steps = append(steps, &toolImageStep{host: h, bundle: b})
+	}
+
+	return append(steps,
+		&serviceReportStep{host: h},
+		&userGroupStep{host: h},
+		&selinuxBooleanStep{host: h},
+		&udevRulesStep{host: h},
+		&vfioBindStep{host: h},
+	)
+}
+
+// StepPlan holds the changes planned by a step.
+type StepPlan struct {
+	Step    string   `json:"step"`
+	Changes []Change `json:"changes"`
+}
+
+// Plan returns the changes of the steps the host is not configured by yet.
+// The steps depending on the changes of the previous steps are planned assuming the previous changes are applied.
+func Plan(ctx context.Context, steps []Step) ([]StepPlan, error) {
+	var plans []StepPlan
+	for _, step := range steps {
+		done, err := step.Check(ctx)
+		if err != nil {
+			return plans, fmt.Errorf("%s: %w", step.Name(), err)
+		}
+		if done {
+			continue
+		}
+
+		changes, err := step.Plan(ctx)
+		if err != nil {
+			return plans, fmt.Errorf("failed to plan %s: %w", step.Name(), err)
+		}
+		if len(changes) > 0 {
+			plans = append(plans, StepPlan{Step: step.Name(), Changes: changes})
+		}
+	}
+
+	return plans, nil
+}
+
+// PrintPlan prints the planned changes as a table.
+func PrintPlan(plans []StepPlan) {
+	if len(plans) == 0 {
+		logger.Infoln("The LPAR is already configured, no changes are required.")
+
+		return
+	}
+
+	p := utils.NewTableWriter()
+	defer p.CloseTableWriter()
+	p.SetHeaders("STEP", "KIND", "TARGET", "CHANGE")
+	for _, plan := range plans {
+		for _, change := range plan.Changes {
+			p.AppendRow(plan.Step, change.Kind, change.Target, change.String())
+		}
+	}
+}
+
+// Apply runs the steps in order and records the changes attempted by each step in the journal.
+// The steps the host is already configured by are skipped.
+func Apply(ctx context.Context, steps []Step, journal *Journal) error {
+	for _, step := range steps {
+		s := spinner.New("Checking " + step.Description())
+		s.Start(ctx)
+
+		done, err := step.Check(ctx)
+		if err != nil {
+			s.Fail(fmt.Sprintf("%s check failed", step.Name()))
+
+			return err
+		}
+		if done {
+			s.Stop(step.Description() + " already configured")
+
+			continue
+		}
+
+		s.UpdateMessage("Configuring " + step.Description())
+		applyErr := step.Apply(ctx, func(change Change, err error) {
+			if recordErr := journal.Record(step.Name(), change, err); recordErr != nil {
+				logger.Warningf("failed to record the change of %s on %s in the journal: %v\n", step.Name(), change.Target, recordErr)
+			}
+		})
+		if applyErr != nil {
+			s.Fail(fmt.Sprintf("failed to configure %s", step.Description()))
+
+			return applyErr
+		}
+		s.Stop(step.Description() + " configured successfully")
+	}
+
+	return nil
+}
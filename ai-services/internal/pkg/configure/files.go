@@ -0,0 +1,141 @@
+package configure
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+)
+
+const (
+	backupFilePermissions   = 0o600
+	restoredFilePermissions = 0o644
+)
+
+// snapshot holds the content of the regular files keyed by their path.
+type snapshot map[string][]byte
+
+// snapshotDirs returns the content of the regular files under the directories. Unreadable entries are skipped.
+func snapshotDirs(fs host.FS, dirs []string) snapshot {
+	snap := snapshot{}
+	for _, dir := range dirs {
+		snapshotDir(fs, dir, snap)
+	}
+
+	return snap
+}
+
+func snapshotDir(fs host.FS, dir string, snap snapshot) {
+	entries, err := fs.ReadDir(dir)
+	if err != nil {
+		return
+	}
+
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		switch {
+		case entry.IsDir():
+			snapshotDir(fs, path, snap)
+		case entry.Type().IsRegular():
+			if data, err := fs.ReadFile(path); err == nil {
+				snap[path] = data
+			}
+		}
+	}
+}
+
+// diffSnapshots returns the file changes between the snapshots. The previous content of the modified and the deleted
+// files is backed up under the backup directory.
+func diffSnapshots(before, after snapshot, backupDir string) ([]Change, error) {
+	var changes []Change
+	for _, path := range sortedPaths(after) {
+		previous, existed := before[path]
+		switch {
+		case !existed:
+			changes = append(changes, Change{Kind: KindFile, Target: path, Action: ActionCreate})
+		case !bytes.Equal(previous, after[path]):
+			backup, err := backupFile(backupDir, path, previous)
+			if err != nil {
+				return changes, err
+			}
+			changes = append(changes, Change{Kind: KindFile, Target: path, Action: ActionModify, Backup: backup})
+		}
+	}
+
+	for _, path := range sortedPaths(before) {
+		if _, exists := after[path]; exists {
+			continue
+		}
+		backup, err := backupFile(backupDir, path, before[path])
+		if err != nil {
+			return changes, err
+		}
+		changes = append(changes, Change{Kind: KindFile, Target: path, Action: ActionDelete, Backup: backup})
+	}
+
+	return changes, nil
+}
+
+// backupFile writes the content of the file under the backup directory and returns the path of the backup.
+func backupFile(backupDir, path string, data []byte) (string, error) {
+	backup := filepath.Join(backupDir, path)
+	if err := os.MkdirAll(filepath.Dir(backup), dirPermissions); err != nil {
+		return "", fmt.Errorf("failed to create backup directory: %w", err)
+	}
+
+	if err := os.WriteFile(backup, data, backupFilePermissions); err != nil {
+		return "", fmt.Errorf("failed to backup %s: %w", path, err)
+	}
+
+	return backup, nil
+}
+
+// restoreFile restores the file from its backup, keeping the permissions of the file if it exists.
+func restoreFile(path, backup string) error {
+	data, err := os.ReadFile(backup)
+	if err != nil {
+		return fmt.Errorf("failed to read backup of %s: %w", path, err)
+	}
+
+	perm := os.FileMode(restoredFilePermissions)
+	if info, err := os.Stat(path); err == nil {
+		perm = info.Mode().Perm()
+	}
+
+	if err := os.WriteFile(path, data, perm); err != nil {
+		return fmt.Errorf("failed to restore %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// removeBackup removes the backup along with the directories under the backup directory it leaves empty.
+func removeBackup(backup, backupDir string) error {
+	if err := os.Remove(backup); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	// removing a directory which is not empty fails, the directories still holding backups are kept
+	for dir := filepath.Dir(backup); dir == backupDir || strings.HasPrefix(dir, backupDir+string(filepath.Separator)); dir = filepath.Dir(dir) {
+		if os.Remove(dir) != nil {
+			break
+		}
+	}
+
+	return nil
+}
+
+func sortedPaths(snap snapshot) []string {
+	paths := make([]string, 0, len(snap))
+	for path := range snap {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+
+	return paths
+}
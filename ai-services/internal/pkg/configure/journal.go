@@ -0,0 +1,121 @@
+package configure
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const journalFilePermissions = 0o644
+
+// Statuses of the journal entries.
+const (
+	StatusApplied = "applied"
+	StatusFailed  = "failed"
+)
+
+// Entry is a change applied on the host, recorded in the journal.
+type Entry struct {
+	Time   time.Time `json:"time"`
+	Step   string    `json:"step"`
+	Change Change    `json:"change"`
+	Status string    `json:"status"`
+	Error  string    `json:"error,omitempty"`
+}
+
+// Journal appends the changes applied on the host to a file, an entry per line in json.
+type Journal struct {
+	path string
+}
+
+// NewJournal returns the journal stored at the given path.
+func NewJournal(path string) *Journal {
+	return &Journal{path: path}
+}
+
+// Path returns the path of the journal file.
+func (j *Journal) Path() string {
+	return j.path
+}
+
+// Record appends the change attempted by the step to the journal, marked failed if it failed to apply.
+func (j *Journal) Record(step string, change Change, applyErr error) error {
+	if err := os.MkdirAll(filepath.Dir(j.path), dirPermissions); err != nil {
+		return fmt.Errorf("failed to create journal directory: %w", err)
+	}
+
+	f, err := os.OpenFile(j.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, journalFilePermissions)
+	if err != nil {
+		return fmt.Errorf("failed to open journal: %w", err)
+	}
+	defer f.Close()
+
+	entry := Entry{Time: time.Now(), Step: step, Change: change, Status: StatusApplied}
+	if applyErr != nil {
+		entry.Status, entry.Error = StatusFailed, applyErr.Error()
+	}
+	if err := json.NewEncoder(f).Encode(entry); err != nil {
+		return fmt.Errorf("failed to write journal entry: %w", err)
+	}
+
+	return nil
+}
+
+// Entries returns the entries recorded in the journal, oldest first. Returns no entries if the journal does not exist.
+func (j *Journal) Entries() ([]Entry, error) {
+	f, err := os.Open(j.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to open journal: %w", err)
+	}
+	defer f.Close()
+
+	var entries []Entry
+	dec := json.NewDecoder(f)
+	for dec.More() {
+		var entry Entry
+		if err := dec.Decode(&entry); err != nil {
+			return entries, fmt.Errorf("failed to read journal %s: %w", j.path, err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// Replace rewrites the journal with the given entries. The journal is removed if there are no entries left.
+func (j *Journal) Replace(entries []Entry) error {
+	if len(entries) == 0 {
+		if err := os.Remove(j.path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove journal: %w", err)
+		}
+
+		return nil
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, entry := range entries {
+		if err := enc.Encode(entry); err != nil {
+			return fmt.Errorf("failed to write journal entry: %w", err)
+		}
+	}
+
+	// write to a temporary file first so that the journal is never left partially written
+	tmp := j.path + ".tmp"
+	if err := os.WriteFile(tmp, buf.Bytes(), journalFilePermissions); err != nil {
+		return fmt.Errorf("failed to write journal: %w", err)
+	}
+
+	if err := os.Rename(tmp, j.path); err != nil {
+		return fmt.Errorf("failed to replace journal: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package configure
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/bundle"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+const (
+	podmanSocketWaitDuration = 2 * time.Second
+	contextTimeout           = 30 * time.Second
+
+	podmanSocket = "podman.socket"
+)
+
+// podmanInstallStep installs podman if it is not installed, from the RPMs of the bundle if set.
+type podmanInstallStep struct {
+	host   *host.Host
+	bundle *bundle.Bundle
+}
+
+func (s *podmanInstallStep) Name() string {
+	return "podman-install"
+}
+
+func (s *podmanInstallStep) Description() string {
+	return "podman installation"
+}
+
+func (s *podmanInstallStep) Check(ctx context.Context) (bool, error) {
+	_, err := s.host.Runner.LookPath("podman")
+
+	return err == nil, nil
+}
+
+func (s *podmanInstallStep) Plan(ctx context.Context) ([]Change, error) {
+	return []Change{s.change()}, nil
+}
+
+func (s *podmanInstallStep) Apply(ctx context.Context, record RecordFunc) error {
+	err := s.install(ctx)
+	record(s.change(), err)
+
+	return err
+}
+
+func (s *podmanInstallStep) change() Change {
+	if s.bundle != nil {
+		return Change{Kind: KindPackage, Target: "podman", Action: ActionInstall, Detail: "from the bundle " + s.bundle.Path()}
+	}
+
+	return Change{Kind: KindPackage, Target: "podman", Action: ActionInstall, Detail: "using dnf"}
+}
+
+func (s *podmanInstallStep) install(ctx context.Context) error {
+	if s.bundle != nil {
+		return s.installFromBundle(ctx)
+	}
+
+	out, err := s.host.Runner.Run(ctx, "dnf", "-y", "install", "podman")
+	if err != nil {
+		return fmt.Errorf("failed to install podman: %v, output: %s", err, string(out))
+	}
+
+	return nil
+}
+
+// installFromBundle installs podman from the RPMs of the bundle without reaching any repository.
+func (s *podmanInstallStep) installFromBundle(ctx context.Context) error {
+	dir, err := os.MkdirTemp("", "ai-services-packages-*")
+	if err != nil {
+		return fmt.Errorf("failed to create packages directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	rpms, err := s.bundle.ExtractPackages(dir)
+	if err != nil {
+		return err
+	}
+
+	args := append([]string{"-y", "install", "--disablerepo=*"}, rpms...)
+	if out, err := s.host.Runner.Run(ctx, "dnf", args...); err != nil {
+		return fmt.Errorf("failed to install podman from the bundle: %v, output: %s", err, string(out))
+	}
+
+	return nil
+}
+
+// toolImageStep loads the tool image used by the servicereport tool from the bundle.
+type toolImageStep struct {
+	host   *host.Host
+	bundle *bundle.Bundle
+}
+
+func (s *toolImageStep) Name() string {
+	return "tool-image"
+}
+
+func (s *toolImageStep) Description() string {
+	return "tool image"
+}
+
+func (s *toolImageStep) Check(ctx context.Context) (bool, error) {
+	if !s.bundle.HasImage(vars.ToolImage) {
+		return false, fmt.Errorf("the bundle does not contain the tool image %s", vars.ToolImage)
+	}
+	_, err := s.host.Runner.Run(ctx, "podman", "image", "exists", vars.ToolImage)
+
+	return err == nil, nil
+}
+
+func (s *toolImageStep) Plan(ctx context.Context) ([]Change, error) {
+	return []Change{s.change()}, nil
+}
+
+func (s *toolImageStep) Apply(ctx context.Context, record RecordFunc) error {
+	err := s.bundle.LoadImages(ctx, s.host.Runner, vars.ToolImage)
+	record(s.change(), err)
+
+	return err
+}
+
+func (s *toolImageStep) change() Change {
+	return Change{Kind: KindImage, Target: vars.ToolImage, Action: ActionLoad, Detail: "from the bundle " + s.bundle.Path()}
+}
+
+// podmanSocketStep starts and enables the podman socket used by the podman client.
+type podmanSocketStep struct {
+	host *host.Host
+}
+
+func (s *podmanSocketStep) Name() string {
+	return "podman-socket"
+}
+
+func (s *podmanSocketStep) Description() string {
+	return "podman configuration"
+}
+
+func (s *podmanSocketStep) Check(ctx context.Context) (bool, error) {
+	return validators.PodmanHealthCheck() == nil, nil
+}
+
+func (s *podmanSocketStep) Plan(ctx context.Context) ([]Change, error) {
+	return []Change{
+		{Kind: KindService, Target: podmanSocket, Action: ActionStart},
+		{Kind: KindService, Target: podmanSocket, Action: ActionEnable},
+	}, nil
+}
+
+func (s *podmanSocketStep) Apply(ctx context.Context, record RecordFunc) error {
+	// start and enable podman socket, the socket started is recorded even if it fails to be enabled
+	for _, action := range []string{ActionStart, ActionEnable} {
+		err := s.systemctl(ctx, action, podmanSocket)
+		record(Change{Kind: KindService, Target: podmanSocket, Action: action}, err)
+		if err != nil {
+			return fmt.Errorf("failed to %s podman socket: %w", action, err)
+		}
+	}
+
+	logger.Infoln("Waiting for podman socket to be ready...", logger.VerbosityLevelDebug)
+	time.Sleep(podmanSocketWaitDuration) // wait for socket to be ready
+
+	if err := validators.PodmanHealthCheck(); err != nil {
+		return fmt.Errorf("podman health check failed after configuration: %w", err)
+	}
+
+	return nil
+}
+
+func (s *podmanSocketStep) systemctl(ctx context.Context, action, unit string) error {
+	ctx, cancel := context.WithTimeout(ctx, contextTimeout)
+	defer cancel()
+
+	out, err := s.host.Runner.Run(ctx, "systemctl", action, unit)
+	if err != nil {
+		return fmt.Errorf("failed to %s %s: %v, output: %s", action, unit, err, string(out))
+	}
+
+	return nil
+}
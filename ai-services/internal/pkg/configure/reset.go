@@ -0,0 +1,180 @@
+package configure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"syscall"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+// errNothingToRevert is returned for the changes which leave nothing behind to revert, Eg:- a reload.
+var errNothingToRevert = errors.New("nothing to revert")
+
+// podmanSteps are the steps kept on reset with the podman installation kept.
+var podmanSteps = []string{"podman-install", "podman-socket"}
+
+// ResetOptions holds the options to reset the host configuration.
+type ResetOptions struct {
+	// KeepPodman keeps podman installed along with its socket enabled
+	KeepPodman bool
+}
+
+// Failure is a change which could not be reverted.
+type Failure struct {
+	Entry  Entry
+	Reason string
+}
+
+// ResetResult holds the outcome of a reset.
+type ResetResult struct {
+	Reverted []Entry
+	Failed   []Failure
+}
+
+// PendingReverts returns the applied changes of the journal to revert, latest first.
+func PendingReverts(entries []Entry, opts ResetOptions) []Entry {
+	var pending []Entry
+	for _, i := range pendingIndices(entries, opts) {
+		pending = append(pending, entries[i])
+	}
+
+	return pending
+}
+
+// pendingIndices returns the indices of the journal entries to revert, latest first.
+func pendingIndices(entries []Entry, opts ResetOptions) []int {
+	var indices []int
+	for i, entry := range slices.Backward(entries) {
+		if entry.Status != StatusApplied {
+			continue
+		}
+		if opts.KeepPodman && slices.Contains(podmanSteps, entry.Step) {
+			continue
+		}
+		indices = append(indices, i)
+	}
+
+	return indices
+}
+
+// Reset reverts the changes recorded in the journal, latest first. The journal keeps the changes which are not
+// reverted, so that the reset can be retried.
+func Reset(ctx context.Context, h *host.Host, journal *Journal, opts ResetOptions) (ResetResult, error) {
+	var result ResetResult
+
+	entries, err := journal.Entries()
+	if err != nil {
+		return result, err
+	}
+
+	reverted := make([]bool, len(entries))
+	reloadUdev := false
+	for _, i := range pendingIndices(entries, opts) {
+		entry := entries[i]
+		err := revert(ctx, h, entry.Change)
+		switch {
+		case errors.Is(err, errNothingToRevert):
+			logger.Infof("%s: %s %s has nothing to revert\n", entry.Step, entry.Change.Kind, entry.Change.Target, logger.VerbosityLevelDebug)
+		case err != nil:
+			result.Failed = append(result.Failed, Failure{Entry: entry, Reason: err.Error()})
+
+			continue
+		}
+		if entry.Change.Kind == KindFile || entry.Change.Target == udevService {
+			reloadUdev = true
+		}
+		reverted[i] = true
+		result.Reverted = append(result.Reverted, entry)
+	}
+
+	// the restored udev rules take effect only once reloaded
+	if reloadUdev {
+		if out, err := h.Runner.Run(ctx, "udevadm", "control", "--reload-rules"); err != nil {
+			logger.Warningf("failed to reload udev rules: %v, output: %s\n", err, string(out))
+		}
+	}
+
+	// the changes which failed to apply are reported once and dropped, the ones not reverted are kept to be retried
+	var remaining []Entry
+	for i, entry := range entries {
+		switch {
+		case entry.Status == StatusFailed:
+			result.Failed = append(result.Failed, Failure{Entry: entry, Reason: "the change failed to apply: " + entry.Error})
+		case !reverted[i]:
+			remaining = append(remaining, entry)
+		}
+	}
+
+	if err := journal.Replace(remaining); err != nil {
+		return result, err
+	}
+
+	// the backups of the restored files are no longer required
+	for _, entry := range result.Reverted {
+		if entry.Change.Backup == "" {
+			continue
+		}
+		if err := removeBackup(entry.Change.Backup, constants.ConfigureBackupPath); err != nil {
+			logger.Warningf("failed to remove the backup of %s: %v\n", entry.Change.Target, err)
+		}
+	}
+
+	return result, nil
+}
+
+// revert reverts the change on the host.
+func revert(ctx context.Context, h *host.Host, change Change) error {
+	switch change.Kind + "/" + change.Action {
+	case KindPackage + "/" + ActionInstall:
+		return run(ctx, h, "dnf", "-y", "remove", change.Target)
+	case KindService + "/" + ActionStart:
+		return run(ctx, h, "systemctl", "stop", change.Target)
+	case KindService + "/" + ActionEnable:
+		return run(ctx, h, "systemctl", "disable", change.Target)
+	case KindFile + "/" + ActionCreate:
+		// directories are removed only if empty, the ones holding files written since are kept
+		err := os.Remove(change.Target)
+		switch {
+		case errors.Is(err, syscall.ENOTEMPTY), errors.Is(err, syscall.EEXIST):
+			return errNothingToRevert
+		case err != nil && !errors.Is(err, os.ErrNotExist):
+			return fmt.Errorf("failed to remove %s: %w", change.Target, err)
+		}
+
+		return nil
+	case KindFile + "/" + ActionModify, KindFile + "/" + ActionDelete:
+		if change.Backup == "" {
+			return fmt.Errorf("no backup of %s is recorded", change.Target)
+		}
+
+		return restoreFile(change.Target, change.Backup)
+	case KindImage + "/" + ActionLoad:
+		return run(ctx, h, "podman", "rmi", change.Target)
+	case KindModule + "/" + ActionLoad:
+		return run(ctx, h, "modprobe", "-r", change.Target)
+	case KindGroup + "/" + ActionCreate:
+		return run(ctx, h, "groupdel", change.Target)
+	case KindBoolean + "/" + ActionEnable:
+		return run(ctx, h, "setsebool", "-P", change.Target+"=0")
+	case KindGroup + "/" + ActionAddMember:
+		return run(ctx, h, "gpasswd", "-d", change.Detail, change.Target)
+	case KindModule + "/" + ActionReload, KindService + "/" + ActionReload, KindCommand + "/" + ActionRun:
+		return errNothingToRevert
+	default:
+		return fmt.Errorf("reverting %s %s is not supported", change.Kind, change.Action)
+	}
+}
+
+func run(ctx context.Context, h *host.Host, name string, args ...string) error {
+	if out, err := h.Runner.Run(ctx, name, args...); err != nil {
+		return fmt.Errorf("failed to run %s: %v, output: %s", name, err, string(out))
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package configure
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/host/hosttest"
+)
+
+func TestResetPartiallyAppliedStep(t *testing.T) {
+	t.Setenv("USER", "alice")
+
+	// groupadd succeeds while usermod is not found
+	fixture := hosttest.Fixture{
+		Files: map[string]string{groupFile: "root:x:0:\n"},
+		Commands: map[string]hosttest.CommandResult{
+			"groupadd " + spyreGroup: {},
+			"groupdel " + spyreGroup: {},
+		},
+	}
+	h, err := fixture.Host()
+	if err != nil {
+		t.Fatal(err)
+	}
+	journal := NewJournal(filepath.Join(t.TempDir(), "journal.jsonl"))
+
+	if err := Apply(t.Context(), []Step{&userGroupStep{host: h}}, journal); err == nil {
+		t.Fatal("expected the step to fail")
+	}
+
+	entries, err := journal.Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct{ action, status string }{{ActionCreate, StatusApplied}, {ActionAddMember, StatusFailed}}
+	if len(entries) != len(want) {
+		t.Fatalf("expected %d journal entries, got %+v", len(want), entries)
+	}
+	for i, w := range want {
+		if entries[i].Change.Action != w.action || entries[i].Status != w.status {
+			t.Errorf("entry %d: expected %s %s, got %s %s", i, w.action, w.status, entries[i].Change.Action, entries[i].Status)
+		}
+	}
+
+	result, err := Reset(t.Context(), h, journal, ResetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Reverted) != 1 || result.Reverted[0].Change.Action != ActionCreate {
+		t.Errorf("expected the group created to be reverted, got %+v", result.Reverted)
+	}
+	if len(result.Failed) != 1 || result.Failed[0].Entry.Change.Action != ActionAddMember {
+		t.Errorf("expected the member failed to be added to be reported, got %+v", result.Failed)
+	}
+	if _, err := os.Stat(journal.Path()); !os.IsNotExist(err) {
+		t.Errorf("expected the journal to be removed, got: %v", err)
+	}
+}
+
+func TestRemoveBackup(t *testing.T) {
+	backupDir := filepath.Join(t.TempDir(), "configure-backup")
+	kept := filepath.Join(backupDir, "1", "etc", "modprobe.d", "vfio.conf")
+	removed := filepath.Join(backupDir, "2", "etc", "udev", "rules.d", "95-vfio.rules")
+	for _, backup := range []string{kept, removed} {
+		if err := os.MkdirAll(filepath.Dir(backup), dirPermissions); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(backup, nil, backupFilePermissions); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := removeBackup(removed, backupDir); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(backupDir, "2")); !os.IsNotExist(err) {
+		t.Errorf("expected the directories of the removed backup to be removed, got: %v", err)
+	}
+	if _, err := os.Stat(kept); err != nil {
+		t.Errorf("expected the other backups to be kept, got: %v", err)
+	}
+
+	if err := removeBackup(kept, backupDir); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(backupDir); !os.IsNotExist(err) {
+		t.Errorf("expected the backup directory to be removed once empty, got: %v", err)
+	}
+}
+
+func TestResetKeepsNonEmptyDirectory(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "rules.d")
+	if err := os.MkdirAll(dir, dirPermissions); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "95-vfio.rules"), nil, backupFilePermissions); err != nil {
+		t.Fatal(err)
+	}
+
+	journal := NewJournal(filepath.Join(t.TempDir(), "journal.jsonl"))
+	if err := journal.Record("host-dirs", dirChange(dir), nil); err != nil {
+		t.Fatal(err)
+	}
+	h, err := (&hosttest.Fixture{Commands: map[string]hosttest.CommandResult{"udevadm control --reload-rules": {}}}).Host()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Reset(t.Context(), h, journal, ResetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Failed) != 0 {
+		t.Errorf("expected nothing to fail, got %+v", result.Failed)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "95-vfio.rules")); err != nil {
+		t.Errorf("expected the directory to be kept along with its files, got: %v", err)
+	}
+	if _, err := os.Stat(journal.Path()); !os.IsNotExist(err) {
+		t.Errorf("expected the journal entry to be dropped, got: %v", err)
+	}
+}
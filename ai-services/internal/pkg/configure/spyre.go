@@ -0,0 +1,438 @@
+package configure
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/selinux"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/servicereport"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/spyre"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/vfio"
+)
+
+const (
+	dirPermissions = 0o755
+
+	vfioPCIModule       = "vfio_pci"
+	spyreGroup          = "sentient"
+	groupFile           = "/etc/group"
+	serviceReportRepair = "servicereport -r -p spyre"
+	vfioDriverInUse     = "Kernel driver in use: vfio-pci"
+	udevService         = "systemd-udevd"
+	containerUseDevices = "container_use_devices"
+)
+
+// Changes of the steps making a single change.
+var (
+	vfioModuleChange    = Change{Kind: KindModule, Target: vfioPCIModule, Action: ActionLoad}
+	vfioReloadChange    = Change{Kind: KindModule, Target: vfioPCIModule, Action: ActionReload}
+	serviceReportChange = Change{Kind: KindCommand, Target: serviceReportRepair, Action: ActionRun, Detail: "in a container"}
+	udevRulesChange     = Change{Kind: KindService, Target: udevService, Action: ActionReload, Detail: "rules"}
+	selinuxChange       = Change{Kind: KindBoolean, Target: containerUseDevices, Action: ActionEnable, Detail: "persistently"}
+)
+
+// hostDirs are mounted into the servicereport tool to persist the spyre configuration on the host.
+var hostDirs = []string{"/etc/modules-load.d", "/etc/udev/rules.d"}
+
+// serviceReportDirs are the host directories the servicereport tool updates in repair mode.
+var serviceReportDirs = []string{"/etc/modprobe.d", "/etc/modules-load.d", "/etc/udev/rules.d", "/etc/security/limits.d", "/etc/sos"}
+
+// hostDirsStep creates the host directories for the servicereport tool. The spyre cards must be attached to the LPAR.
+type hostDirsStep struct {
+	host *host.Host
+}
+
+func (s *hostDirsStep) Name() string {
+	return "host-dirs"
+}
+
+func (s *hostDirsStep) Description() string {
+	return "spyre host directories"
+}
+
+func (s *hostDirsStep) Check(ctx context.Context) (bool, error) {
+	// validate spyre attachment first before configuring the spyre cards
+	if err := spyre.NewSpyreRule(s.host).Verify(ctx); err != nil {
+		return false, err
+	}
+
+	return len(s.missing()) == 0, nil
+}
+
+func (s *hostDirsStep) Plan(ctx context.Context) ([]Change, error) {
+	var changes []Change
+	for _, dir := range s.missing() {
+		changes = append(changes, dirChange(dir))
+	}
+
+	return changes, nil
+}
+
+func (s *hostDirsStep) Apply(ctx context.Context, record RecordFunc) error {
+	for _, dir := range s.missing() {
+		err := os.MkdirAll(dir, dirPermissions)
+		record(dirChange(dir), err)
+		if err != nil {
+			return fmt.Errorf("failed to create host volume mounts for servicereport tool %w", err)
+		}
+	}
+
+	return nil
+}
+
+func dirChange(dir string) Change {
+	return Change{Kind: KindFile, Target: dir, Action: ActionCreate, Detail: "directory"}
+}
+
+func (s *hostDirsStep) missing() []string {
+	var missing []string
+	for _, dir := range hostDirs {
+		if _, err := s.host.FS.Stat(dir); err != nil {
+			missing = append(missing, dir)
+		}
+	}
+
+	return missing
+}
+
+// vfioModuleStep loads the vfio_pci kernel module required by the spyre cards.
+type vfioModuleStep struct {
+	host *host.Host
+}
+
+func (s *vfioModuleStep) Name() string {
+	return "vfio-module"
+}
+
+func (s *vfioModuleStep) Description() string {
+	return "vfio kernel modules"
+}
+
+func (s *vfioModuleStep) Check(ctx context.Context) (bool, error) {
+	return vfio.NewVfioRule(s.host).Verify(ctx) == nil, nil
+}
+
+func (s *vfioModuleStep) Plan(ctx context.Context) ([]Change, error) {
+	return []Change{vfioModuleChange}, nil
+}
+
+func (s *vfioModuleStep) Apply(ctx context.Context, record RecordFunc) error {
+	err := vfio.NewVfioRule(s.host).Fix(ctx)
+	record(vfioModuleChange, err)
+	if err != nil {
+		return fmt.Errorf("failed to load vfio kernel modules for spyre %w", err)
+	}
+	logger.Infoln("VFIO kernel modules loaded on the host", logger.VerbosityLevelDebug)
+
+	return nil
+}
+
+// serviceReportStep runs the servicereport tool in repair mode to configure the spyre cards.
+// The tool is run on every configure as it repairs only the configurations which are not in place.
+// The files updated by the tool are recorded along with a backup of their previous content.
+type serviceReportStep struct {
+	host *host.Host
+}
+
+func (s *serviceReportStep) Name() string {
+	return "servicereport"
+}
+
+func (s *serviceReportStep) Description() string {
+	return "spyre card configuration"
+}
+
+func (s *serviceReportStep) Check(ctx context.Context) (bool, error) {
+	return false, nil
+}
+
+// Plan runs the servicereport tool in validate mode to list the checks failing along with the files it reports, which
+// the repair updates. The files the repair may update are listed instead if the tool cannot be run yet, Eg:- podman is
+// installed by a previous step.
+func (s *serviceReportStep) Plan(ctx context.Context) ([]Change, error) {
+	if _, err := s.host.Runner.LookPath("podman"); err != nil {
+		return s.possibleChanges(), nil
+	}
+
+	out, err := helpers.ServiceReportOutput(ctx, s.host.Runner, servicereport.ValidateCommand, "validate")
+	if err == nil {
+		return nil, nil
+	}
+
+	checks, files := parseServiceReport(out)
+	if len(checks) == 0 {
+		logger.Infof("failed to list the servicereport checks failing: %v, output: %s\n", err, out, logger.VerbosityLevelDebug)
+
+		return s.possibleChanges(), nil
+	}
+
+	changes := []Change{serviceReportChange}
+	for _, check := range checks {
+		changes = append(changes, Change{Kind: KindCheck, Target: check, Action: ActionRepair})
+	}
+	for _, file := range files {
+		changes = append(changes, Change{Kind: KindFile, Target: file, Action: ActionUpdate})
+	}
+
+	return changes, nil
+}
+
+// possibleChanges returns the directories the servicereport tool may update in repair mode.
+func (s *serviceReportStep) possibleChanges() []Change {
+	changes := []Change{serviceReportChange}
+	for _, dir := range serviceReportDirs {
+		changes = append(changes, Change{Kind: KindFile, Target: dir, Action: ActionUpdate, Detail: "spyre configuration files, if required"})
+	}
+
+	return changes
+}
+
+// parseServiceReport returns the checks failing in the output of the servicereport tool in validate mode along with
+// the files under the directories it repairs, mentioned in the output.
+// Eg:- "VFIO udev rules ........ FAIL" followed by "/etc/udev/rules.d/95-vfio-3.rules is missing".
+func parseServiceReport(out []byte) ([]string, []string) {
+	var checks, files []string
+	for line := range strings.Lines(string(out)) {
+		fields := strings.Fields(line)
+		if len(fields) > 1 && slices.Contains([]string{"FAIL", "FAILED"}, strings.ToUpper(fields[len(fields)-1])) {
+			check := strings.Trim(strings.Join(fields[:len(fields)-1], " "), " .:")
+			if check != "" && !slices.Contains(checks, check) {
+				checks = append(checks, check)
+			}
+		}
+
+		for _, field := range fields {
+			file := strings.Trim(field, "\"'`.,:;()")
+			if !slices.Contains(files, file) && slices.ContainsFunc(serviceReportDirs, func(dir string) bool {
+				return strings.HasPrefix(file, dir+"/")
+			}) {
+				files = append(files, file)
+			}
+		}
+	}
+
+	return checks, files
+}
+
+func (s *serviceReportStep) Apply(ctx context.Context, record RecordFunc) error {
+	before := snapshotDirs(s.host.FS, serviceReportDirs)
+	runErr := helpers.RunServiceReportContainer(ctx, s.host.Runner, serviceReportRepair, "configure")
+	record(serviceReportChange, runErr)
+	after := snapshotDirs(s.host.FS, serviceReportDirs)
+
+	// the files updated by the tool are recorded even if it failed, to be reverted
+	changes, err := diffSnapshots(before, after, filepath.Join(constants.ConfigureBackupPath, strconv.FormatInt(time.Now().UnixNano(), 10)))
+	for _, change := range changes {
+		record(change, nil)
+	}
+	if runErr != nil {
+		return runErr
+	}
+
+	return err
+}
+
+// userGroupStep adds the current user to the group owning the spyre devices.
+type userGroupStep struct {
+	host *host.Host
+}
+
+func (s *userGroupStep) Name() string {
+	return "user-group"
+}
+
+func (s *userGroupStep) Description() string {
+	return "spyre user group"
+}
+
+func (s *userGroupStep) Check(ctx context.Context) (bool, error) {
+	exists, members := s.group()
+
+	return exists && slices.Contains(members, currentUser()), nil
+}
+
+func (s *userGroupStep) Plan(ctx context.Context) ([]Change, error) {
+	exists, members := s.group()
+
+	var changes []Change
+	if !exists {
+		changes = append(changes, Change{Kind: KindGroup, Target: spyreGroup, Action: ActionCreate})
+	}
+	if !slices.Contains(members, currentUser()) {
+		changes = append(changes, Change{Kind: KindGroup, Target: spyreGroup, Action: ActionAddMember, Detail: currentUser()})
+	}
+
+	return changes, nil
+}
+
+func (s *userGroupStep) Apply(ctx context.Context, record RecordFunc) error {
+	if exists, _ := s.group(); !exists {
+		out, err := s.host.Runner.Run(ctx, "groupadd", spyreGroup)
+		if err != nil {
+			err = fmt.Errorf("failed to create %s group. Error: %w, output: %s", spyreGroup, err, string(out))
+		}
+		record(Change{Kind: KindGroup, Target: spyreGroup, Action: ActionCreate}, err)
+		if err != nil {
+			return err
+		}
+	}
+
+	out, err := s.host.Runner.Run(ctx, "usermod", "-aG", spyreGroup, currentUser())
+	if err != nil {
+		err = fmt.Errorf("failed to add current user to the %s group. Error: %w, output: %s", spyreGroup, err, string(out))
+	}
+	record(Change{Kind: KindGroup, Target: spyreGroup, Action: ActionAddMember, Detail: currentUser()}, err)
+
+	return err
+}
+
+// group returns whether the spyre group exists along with its members.
+func (s *userGroupStep) group() (bool, []string) {
+	data, err := s.host.FS.ReadFile(groupFile)
+	if err != nil {
+		return false, nil
+	}
+
+	for line := range strings.Lines(string(data)) {
+		// group entries are in the format name:password:gid:member1,member2
+		fields := strings.Split(strings.TrimSpace(line), ":")
+		if len(fields) == 4 && fields[0] == spyreGroup {
+			return true, strings.Split(fields[3], ",")
+		}
+	}
+
+	return false, nil
+}
+
+// currentUser returns the name of the user running the command.
+func currentUser() string {
+	if name := os.Getenv("USER"); name != "" {
+		return name
+	}
+	if u, err := user.Current(); err == nil {
+		return u.Username
+	}
+
+	return ""
+}
+
+// selinuxBooleanStep allows the containers to use the spyre devices when SELinux is enforcing.
+type selinuxBooleanStep struct {
+	host *host.Host
+}
+
+func (s *selinuxBooleanStep) Name() string {
+	return "selinux-boolean"
+}
+
+func (s *selinuxBooleanStep) Description() string {
+	return "SELinux container device access"
+}
+
+func (s *selinuxBooleanStep) Check(ctx context.Context) (bool, error) {
+	return selinux.NewSELinuxRule(s.host).Verify(ctx) == nil, nil
+}
+
+func (s *selinuxBooleanStep) Plan(ctx context.Context) ([]Change, error) {
+	return []Change{selinuxChange}, nil
+}
+
+func (s *selinuxBooleanStep) Apply(ctx context.Context, record RecordFunc) error {
+	err := selinux.NewSELinuxRule(s.host).Fix(ctx)
+	record(selinuxChange, err)
+
+	return err
+}
+
+// udevRulesStep reloads the udev rules written by the servicereport tool.
+type udevRulesStep struct {
+	host *host.Host
+}
+
+func (s *udevRulesStep) Name() string {
+	return "udev-rules"
+}
+
+func (s *udevRulesStep) Description() string {
+	return "udev rules"
+}
+
+func (s *udevRulesStep) Check(ctx context.Context) (bool, error) {
+	return false, nil
+}
+
+func (s *udevRulesStep) Plan(ctx context.Context) ([]Change, error) {
+	return []Change{udevRulesChange}, nil
+}
+
+func (s *udevRulesStep) Apply(ctx context.Context, record RecordFunc) error {
+	out, err := s.host.Runner.Run(ctx, "udevadm", "control", "--reload-rules")
+	if err != nil {
+		err = fmt.Errorf("failed to reload udev rules. Error: %w, output: %s", err, string(out))
+	}
+	record(udevRulesChange, err)
+
+	return err
+}
+
+// vfioBindStep reloads the vfio_pci kernel module if any of the spyre cards are not bound to it.
+type vfioBindStep struct {
+	host *host.Host
+}
+
+func (s *vfioBindStep) Name() string {
+	return "vfio-bind"
+}
+
+func (s *vfioBindStep) Description() string {
+	return "spyre cards vfio binding"
+}
+
+func (s *vfioBindStep) Check(ctx context.Context) (bool, error) {
+	cards, err := helpers.ListSpyreCards(ctx, s.host.Runner)
+	if err != nil || len(cards) == 0 {
+		return false, fmt.Errorf("failed to list spyre cards on LPAR %w", err)
+	}
+
+	out, err := s.host.Runner.Run(ctx, "lspci", "-k", "-d", "1014:06a7")
+	if err != nil {
+		return false, fmt.Errorf("failed to check vfio cards with kernel modules loaded %w", err)
+	}
+
+	bound := strings.Count(string(out), vfioDriverInUse)
+	logger.Infof("%d of %d spyre cards are bound to vfio-pci\n", bound, len(cards), logger.VerbosityLevelDebug)
+
+	return bound == len(cards), nil
+}
+
+func (s *vfioBindStep) Plan(ctx context.Context) ([]Change, error) {
+	return []Change{vfioReloadChange}, nil
+}
+
+func (s *vfioBindStep) Apply(ctx context.Context, record RecordFunc) error {
+	logger.Infof("failed to detect vfio cards, reloading vfio kernel modules..\n")
+	// the module may not be loaded yet
+	_, _ = s.host.Runner.Run(ctx, "rmmod", vfioPCIModule)
+	out, err := s.host.Runner.Run(ctx, "modprobe", vfioPCIModule)
+	if err != nil {
+		err = fmt.Errorf("failed to reload vfio kernel modules for spyre: %v, output: %s", err, string(out))
+	}
+	record(vfioReloadChange, err)
+	if err != nil {
+		return err
+	}
+	logger.Infoln("VFIO kernel modules reloaded on the host", logger.VerbosityLevelDebug)
+
+	return nil
+}
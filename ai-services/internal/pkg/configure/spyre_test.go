@@ -0,0 +1,70 @@
+package configure
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/host/hosttest"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+func TestServiceReportPlan(t *testing.T) {
+	validate := strings.Join([]string{
+		"podman run --privileged --rm --name servicereport",
+		"-v /etc/group:/etc/group:ro -v /etc/modprobe.d:/etc/modprobe.d:ro -v /etc/modules-load.d/:/etc/modules-load.d/:ro",
+		"-v /etc/udev/rules.d/:/etc/udev/rules.d/:ro -v /etc/security/limits.d/:/etc/security/limits.d/:ro -v /etc/sos:/etc/sos:ro",
+		vars.ToolImage, "bash -c servicereport -v -p spyre",
+	}, " ")
+	report := `Spyre configuration checks
+  VFIO kernel module ............. PASS
+  VFIO udev rules ................ FAIL
+    /etc/udev/rules.d/95-vfio-3.rules is missing
+  Memlock limit .................. FAIL
+    memlock is not set in '/etc/security/limits.d/memlock.conf'.
+`
+
+	tests := map[string]struct {
+		commands map[string]hosttest.CommandResult
+		want     []Change
+	}{
+		"checks failing": {
+			commands: map[string]hosttest.CommandResult{validate: {Output: report, ExitCode: 1}},
+			want: []Change{
+				serviceReportChange,
+				{Kind: KindCheck, Target: "VFIO udev rules", Action: ActionRepair},
+				{Kind: KindCheck, Target: "Memlock limit", Action: ActionRepair},
+				{Kind: KindFile, Target: "/etc/udev/rules.d/95-vfio-3.rules", Action: ActionUpdate},
+				{Kind: KindFile, Target: "/etc/security/limits.d/memlock.conf", Action: ActionUpdate},
+			},
+		},
+		"checks passing": {
+			commands: map[string]hosttest.CommandResult{validate: {Output: "VFIO udev rules ... PASS\n"}},
+		},
+		"podman not installed yet": {
+			want: (&serviceReportStep{}).possibleChanges(),
+		},
+		"tool failing to run": {
+			commands: map[string]hosttest.CommandResult{validate: {Output: "Error: image not known", ExitCode: 125}},
+			want:     (&serviceReportStep{}).possibleChanges(),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fixture := hosttest.Fixture{Commands: tt.commands}
+			h, err := fixture.Host()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			changes, err := (&serviceReportStep{host: h}).Plan(t.Context())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(changes, tt.want) {
+				t.Errorf("expected the changes\n%#v\ngot\n%#v", tt.want, changes)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package connection
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+const (
+	// LocalURI is the default Podman socket URI on the local machine.
+	LocalURI = "unix:///run/podman/podman.sock"
+
+	configDirName  = "ai-services"
+	configFileName = "connections.yaml"
+	configDirPerm  = 0o700
+	configFilePerm = 0o600
+)
+
+var supportedSchemes = []string{"unix", "ssh", "tcp"}
+
+// Connection represents a named Podman endpoint.
+type Connection struct {
+	Name     string `yaml:"-"`
+	URI      string `yaml:"uri"`
+	Identity string `yaml:"identity,omitempty"`
+}
+
+// Config holds all the named Podman endpoints along with the default one to use.
+type Config struct {
+	Default     string                `yaml:"default,omitempty"`
+	Connections map[string]Connection `yaml:"connections,omitempty"`
+
+	path string
+}
+
+// ConfigPath returns the path of the connections config file.
+// Defaults to $XDG_CONFIG_HOME/ai-services/connections.yaml (or ~/.config/ai-services/connections.yaml).
+func ConfigPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to determine user config directory: %w", err)
+	}
+
+	return filepath.Join(dir, configDirName, configFileName), nil
+}
+
+// Load reads the connections config file. Returns an empty config if the file does not exist yet.
+func Load() (*Config, error) {
+	path, err := ConfigPath()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &Config{Connections: map[string]Connection{}, path: path}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read connections config %s: %w", path, err)
+	}
+
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse connections config %s: %w", path, err)
+	}
+
+	if cfg.Connections == nil {
+		cfg.Connections = map[string]Connection{}
+	}
+
+	return cfg, nil
+}
+
+// Save writes the config back to the connections config file.
+func (c *Config) Save() error {
+	if err := os.MkdirAll(filepath.Dir(c.path), configDirPerm); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed to marshal connections config: %w", err)
+	}
+
+	if err := os.WriteFile(c.path, data, configFilePerm); err != nil {
+		return fmt.Errorf("failed to write connections config %s: %w", c.path, err)
+	}
+
+	return nil
+}
+
+// Add adds a new named connection. The first connection added becomes the default one.
+func (c *Config) Add(conn Connection) error {
+	if _, exists := c.Connections[conn.Name]; exists {
+		return fmt.Errorf("connection '%s' already exists", conn.Name)
+	}
+
+	if err := Validate(conn); err != nil {
+		return err
+	}
+
+	c.Connections[conn.Name] = conn
+	if c.Default == "" {
+		c.Default = conn.Name
+	}
+
+	return nil
+}
+
+// Remove removes the named connection. Unsets the default if the removed connection was the default one.
+func (c *Config) Remove(name string) error {
+	if _, exists := c.Connections[name]; !exists {
+		return fmt.Errorf("connection '%s' does not exist", name)
+	}
+
+	delete(c.Connections, name)
+	if c.Default == name {
+		c.Default = ""
+	}
+
+	return nil
+}
+
+// Use marks the named connection as the default one.
+func (c *Config) Use(name string) error {
+	if _, exists := c.Connections[name]; !exists {
+		return fmt.Errorf("connection '%s' does not exist", name)
+	}
+
+	c.Default = name
+
+	return nil
+}
+
+// Get returns the named connection.
+func (c *Config) Get(name string) (Connection, error) {
+	conn, exists := c.Connections[name]
+	if !exists {
+		return Connection{}, fmt.Errorf("connection '%s' does not exist. Please use 'ai-services connection list' to see available connections", name)
+	}
+	conn.Name = name
+
+	return conn, nil
+}
+
+// List returns all the named connections sorted by name.
+func (c *Config) List() []Connection {
+	names := utils.ExtractMapKeys(c.Connections)
+	sort.Strings(names)
+
+	conns := make([]Connection, 0, len(names))
+	for _, name := range names {
+		conn := c.Connections[name]
+		conn.Name = name
+		conns = append(conns, conn)
+	}
+
+	return conns
+}
+
+// Validate verifies the connection name, URI scheme and identity file.
+func Validate(conn Connection) error {
+	if err := utils.VerifyAppName(conn.Name); err != nil {
+		return fmt.Errorf("invalid connection name: %s", conn.Name)
+	}
+
+	u, err := url.Parse(conn.URI)
+	if err != nil {
+		return fmt.Errorf("invalid connection URI %s: %w", conn.URI, err)
+	}
+
+	if !slices.Contains(supportedSchemes, u.Scheme) {
+		return fmt.Errorf("unsupported connection URI scheme %q: must be one of %v", u.Scheme, supportedSchemes)
+	}
+
+	if conn.Identity != "" {
+		if u.Scheme != "ssh" {
+			return fmt.Errorf("identity file is only supported for ssh connections")
+		}
+		if !utils.FileExists(conn.Identity) {
+			return fmt.Errorf("identity file '%s' does not exist", conn.Identity)
+		}
+	}
+
+	return nil
+}
+
+// Resolve returns the connection to use.
+// Precedence: named connection (--connection) > CONTAINER_HOST env > default connection in config > local socket.
+func Resolve(name string) (Connection, error) {
+	if name == "" {
+		if v, found := os.LookupEnv("CONTAINER_HOST"); found {
+			return Connection{URI: v, Identity: os.Getenv("CONTAINER_SSHKEY")}, nil
+		}
+	}
+
+	cfg, err := Load()
+	if err != nil {
+		return Connection{}, err
+	}
+
+	if name == "" {
+		name = cfg.Default
+	}
+
+	if name == "" {
+		return Connection{URI: LocalURI}, nil
+	}
+
+	return cfg.Get(name)
+}
@@ -0,0 +1,185 @@
+package connection
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// useTempConfig points the connections config at a temporary directory, without any connection set by the env.
+func useTempConfig(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("CONTAINER_HOST", "")
+	os.Unsetenv("CONTAINER_HOST")
+
+	return filepath.Join(dir, configDirName, configFileName)
+}
+
+// saveConfig saves a config holding the connections, the first one being the default.
+func saveConfig(t *testing.T, conns ...Connection) {
+	t.Helper()
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, conn := range conns {
+		if err := cfg.Add(conn); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := cfg.Save(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadAndSave(t *testing.T) {
+	path := useTempConfig(t)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Default != "" || len(cfg.Connections) != 0 {
+		t.Fatalf("expected an empty config without a config file, got %+v", cfg)
+	}
+
+	identity := filepath.Join(t.TempDir(), "id_ed25519")
+	if err := os.WriteFile(identity, nil, configFilePerm); err != nil {
+		t.Fatal(err)
+	}
+	saveConfig(t,
+		Connection{Name: "lpar1", URI: "ssh://root@lpar1/run/podman/podman.sock", Identity: identity},
+		Connection{Name: "local", URI: LocalURI},
+	)
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != configFilePerm {
+		t.Errorf("expected the config to be readable by its owner only, got %s", info.Mode().Perm())
+	}
+
+	loaded, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Default != "lpar1" {
+		t.Errorf("expected the first connection added to be the default, got '%s'", loaded.Default)
+	}
+	conns := loaded.List()
+	if len(conns) != 2 || conns[0].Name != "local" || conns[1].Name != "lpar1" || conns[1].Identity != identity {
+		t.Errorf("expected the connections sorted by name along with their identity, got %+v", conns)
+	}
+}
+
+func TestLoadInvalidConfig(t *testing.T) {
+	path := useTempConfig(t)
+	if err := os.MkdirAll(filepath.Dir(path), configDirPerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("connections: [lpar1"), configFilePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), "failed to parse connections config") {
+		t.Errorf("expected the config to fail to parse, got: %v", err)
+	}
+}
+
+func TestAdd(t *testing.T) {
+	cfg := &Config{Connections: map[string]Connection{}}
+	if err := cfg.Add(Connection{Name: "lpar1", URI: "tcp://lpar1:8080"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := cfg.Add(Connection{Name: "lpar1", URI: "tcp://lpar2:8080"}); err == nil || !strings.Contains(err.Error(), "connection 'lpar1' already exists") {
+		t.Errorf("expected the duplicate connection to be rejected, got: %v", err)
+	}
+	if cfg.Connections["lpar1"].URI != "tcp://lpar1:8080" {
+		t.Errorf("expected the existing connection to be kept, got %+v", cfg.Connections["lpar1"])
+	}
+
+	invalid := map[string]Connection{
+		"unsupported connection URI scheme": {Name: "lpar2", URI: "http://lpar2:8080"},
+		"identity file is only supported":   {Name: "lpar2", URI: "tcp://lpar2:8080", Identity: "/root/.ssh/id_ed25519"},
+		"does not exist":                    {Name: "lpar2", URI: "ssh://root@lpar2/run/podman/podman.sock", Identity: "/nonexistent/id_ed25519"},
+	}
+	for wantErr, conn := range invalid {
+		if err := cfg.Add(conn); err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Errorf("expected %+v to be rejected with '%s', got: %v", conn, wantErr, err)
+		}
+	}
+	if len(cfg.Connections) != 1 {
+		t.Errorf("expected the invalid connections not to be added, got %+v", cfg.Connections)
+	}
+}
+
+func TestRemoveAndUse(t *testing.T) {
+	cfg := &Config{Connections: map[string]Connection{}}
+	for _, name := range []string{"lpar1", "lpar2"} {
+		if err := cfg.Add(Connection{Name: name, URI: "tcp://" + name + ":8080"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := cfg.Use("lpar2"); err != nil || cfg.Default != "lpar2" {
+		t.Fatalf("expected lpar2 to be the default, got '%s': %v", cfg.Default, err)
+	}
+	if err := cfg.Use("lpar3"); err == nil || cfg.Default != "lpar2" {
+		t.Errorf("expected the unknown connection not to be used, got '%s': %v", cfg.Default, err)
+	}
+
+	// removing the default connection unsets the default, without picking another one
+	if err := cfg.Remove("lpar2"); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Default != "" {
+		t.Errorf("expected the default to be unset, got '%s'", cfg.Default)
+	}
+	if err := cfg.Remove("lpar2"); err == nil || !strings.Contains(err.Error(), "connection 'lpar2' does not exist") {
+		t.Errorf("expected the removed connection not to exist, got: %v", err)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	useTempConfig(t)
+	saveConfig(t,
+		Connection{Name: "lpar1", URI: "tcp://lpar1:8080"},
+		Connection{Name: "lpar2", URI: "tcp://lpar2:8080"},
+	)
+
+	// the default connection of the config
+	if conn, err := Resolve(""); err != nil || conn.Name != "lpar1" || conn.URI != "tcp://lpar1:8080" {
+		t.Errorf("expected the default connection lpar1, got %+v: %v", conn, err)
+	}
+
+	// the env over the default connection
+	t.Setenv("CONTAINER_HOST", "ssh://root@lpar3/run/podman/podman.sock")
+	t.Setenv("CONTAINER_SSHKEY", "/root/.ssh/id_ed25519")
+	conn, err := Resolve("")
+	if err != nil || conn.URI != "ssh://root@lpar3/run/podman/podman.sock" || conn.Identity != "/root/.ssh/id_ed25519" {
+		t.Errorf("expected the connection of the env, got %+v: %v", conn, err)
+	}
+
+	// the flag over the env
+	if conn, err := Resolve("lpar2"); err != nil || conn.Name != "lpar2" || conn.URI != "tcp://lpar2:8080" {
+		t.Errorf("expected the named connection lpar2, got %+v: %v", conn, err)
+	}
+	if _, err := Resolve("lpar3"); err == nil || !strings.Contains(err.Error(), "connection 'lpar3' does not exist") {
+		t.Errorf("expected the unknown connection to fail, got: %v", err)
+	}
+}
+
+func TestResolveLocal(t *testing.T) {
+	useTempConfig(t)
+
+	if conn, err := Resolve(""); err != nil || conn.URI != LocalURI || conn.Name != "" {
+		t.Errorf("expected the local socket without any connection, got %+v: %v", conn, err)
+	}
+}
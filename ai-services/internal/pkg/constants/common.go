@@ -3,7 +3,23 @@ package constants
 const (
 	PodStartOn       = "on"
 	PodStartOff      = "off"
+	DataPath         = "/var/lib/ai-services"
 	ApplicationsPath = "/var/lib/ai-services/applications"
+	// ValidationReportFile is the bootstrap validation report stored within the application directory on create.
+	ValidationReportFile = "validation-report.json"
+	// ValuesFile holds the values the application is deployed with, stored within the application directory on create.
+	ValuesFile = "values.yaml"
+	// ModelRevisionsFile holds the commits of the models the application is deployed with, stored within the
+	// application directory on create.
+	ModelRevisionsFile = "models.json"
+	// RenderedTemplatesDir holds the rendered pod templates, stored within the application directory on create.
+	RenderedTemplatesDir = "rendered"
+	// ConfigureJournalPath records the changes applied on the host by bootstrap configure.
+	ConfigureJournalPath = "/var/lib/ai-services/configure-journal.jsonl"
+	// ConfigureBackupPath holds the copies of the host files taken before bootstrap configure modified them.
+	ConfigureBackupPath = "/var/lib/ai-services/configure-backup"
+	// SMTStatePath records the original SMT level along with the applications requiring a level.
+	SMTStatePath = "/var/lib/ai-services/smt.json"
 )
 
 type ValidationLevel int
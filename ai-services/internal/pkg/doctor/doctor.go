@@ -0,0 +1,331 @@
+package doctor
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/containers/podman/v5/libpod/define"
+	"github.com/docker/go-units"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/ports"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// Severity ranks the findings, the most severe first.
+type Severity string
+
+const (
+	SeverityCritical Severity = "critical"
+	SeverityError    Severity = "error"
+	SeverityWarning  Severity = "warning"
+
+	// logTailSize is the size of the end of the logs scanned for the startup errors.
+	logTailSize    = 1 * units.MiB
+	vfioDevicePath = "/dev/vfio"
+
+	exitCodeNotExecutable = 126
+	exitCodeNotFound      = 127
+	exitCodeKilled        = 137
+	exitCodeSegfault      = 139
+	exitCodeTerminated    = 143
+)
+
+// rank orders the severities, the most severe first.
+var rank = map[Severity]int{SeverityCritical: 0, SeverityError: 1, SeverityWarning: 2}
+
+// Finding is a problem detected in the application along with the hint to remediate it.
+type Finding struct {
+	Severity  Severity `json:"severity"`
+	Pod       string   `json:"pod,omitempty"`
+	Container string   `json:"container,omitempty"`
+	// Check is the name of the check which detected the problem, Eg:- oom-killed
+	Check   string `json:"check"`
+	Message string `json:"message"`
+	Hint    string `json:"hint"`
+}
+
+// IsProblem reports whether the finding is a problem rather than a warning.
+func (f Finding) IsProblem() bool {
+	return f.Severity != SeverityWarning
+}
+
+// logSignature is a startup error recognizable in the container logs.
+type logSignature struct {
+	pattern  *regexp.Regexp
+	severity Severity
+	message  string
+	hint     string
+}
+
+// logSignatures are the common startup errors of the vLLM server and the other containers, anchored to the messages
+// logged by vLLM, the Python runtime, the hub client and the OCI runtime, not to match the unrelated lines.
+var logSignatures = []logSignature{
+	{regexp.MustCompile(`torch\.OutOfMemoryError|\bMemoryError: |std::bad_alloc|RuntimeError: .*out of memory`), SeverityCritical,
+		"the model ran out of memory while loading",
+		"Lower the max model length or the batch size of the model, or increase the memory assigned to the LPAR"},
+	{regexp.MustCompile(`No available memory for the cache blocks`), SeverityCritical, "no memory is left for the KV cache blocks",
+		"Lower the max model length or the batch size of the model"},
+	{regexp.MustCompile(`max seq len|max_model_len \(\d+\) is greater than the derived max_model_len`), SeverityError,
+		"the max model length exceeds the length supported by the model",
+		"Lower the max model length of the model"},
+	{regexp.MustCompile(`does not appear to have a file named`), SeverityError, "the model files are incomplete",
+		"Download the model again using `ai-services application model download`"},
+	{regexp.MustCompile(`Repository Not Found`), SeverityError, "the model is not found",
+		"Verify the model name, or download it using `ai-services application model download`"},
+	{regexp.MustCompile(`Engine core initialization failed`), SeverityError, "the vLLM engine failed to start",
+		"Inspect the errors above the failure in the container logs using `ai-services application logs`"},
+	{regexp.MustCompile(`No Spyre`), SeverityCritical, "no Spyre card is visible to the container",
+		"Verify the Spyre cards are bound to vfio-pci using `ai-services bootstrap validate`"},
+	{regexp.MustCompile(`(?i)\[Errno 98\].*address already in use`), SeverityError, "a port required by the container is in use",
+		"Stop the processes using the port (see `ss -ltnp`) or override the port using --params"},
+	{regexp.MustCompile(`(?i)PermissionError: \[Errno 13\] Permission denied|/dev/vfio/\S*: Permission denied|\b(?:crun|runc|conmon)\b.*: Permission denied`),
+		SeverityError, "the container was denied access to a file or device",
+		"Verify the SELinux labels and the ownership of the mounted directories, and that the LPAR is configured using `ai-services bootstrap configure`"},
+}
+
+// Diagnose inspects the pods and the containers of the application and returns the findings ranked by severity.
+func Diagnose(ctx context.Context, rt runtime.Runtime, h *host.Host, app string) ([]Finding, error) {
+	pods, err := rt.ListPods(ctx, map[string][]string{"label": {fmt.Sprintf("%s=%s", constants.ApplicationAnnotationKey, app)}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	if len(pods) == 0 {
+		return nil, fmt.Errorf("application '%s' does not exist", app)
+	}
+
+	var findings []Finding
+	template := ""
+	for _, pod := range pods {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if template == "" {
+			template = pod.Labels[string(vars.TemplateLabel)]
+		}
+		findings = append(findings, diagnosePod(ctx, rt, h, pod)...)
+	}
+
+	findings = append(findings, checkModels(h, template, app)...)
+
+	slices.SortStableFunc(findings, func(a, b Finding) int {
+		return cmp.Compare(rank[a.Severity], rank[b.Severity])
+	})
+
+	return findings, nil
+}
+
+func diagnosePod(ctx context.Context, rt runtime.Runtime, h *host.Host, pod runtime.Pod) []Finding {
+	var findings []Finding
+	report, err := rt.InspectPod(ctx, pod.ID)
+	if err != nil {
+		logger.Infof("failed to inspect pod %s: %v\n", pod.Name, err, logger.VerbosityLevelDebug)
+	} else if report.InspectPodData != nil {
+		findings = append(findings, checkPodPorts(pod.Name, report.InspectPodData)...)
+	}
+
+	for _, container := range pod.Containers {
+		data, err := rt.InspectContainer(ctx, container.ID)
+		if err != nil {
+			logger.Infof("failed to inspect container %s: %v\n", container.Name, err, logger.VerbosityLevelDebug)
+
+			continue
+		}
+
+		containerFindings := checkContainer(pod.Name, container.Name, data)
+		containerFindings = append(containerFindings, checkDevices(h, pod.Name, container.Name, data)...)
+
+		// the logs of the healthy containers are not scanned, their past errors are of no interest
+		if len(containerFindings) > 0 || !isHealthy(data) {
+			containerFindings = append(containerFindings, checkLogs(ctx, rt, pod.Name, container.Name, container.ID)...)
+		}
+		findings = append(findings, containerFindings...)
+	}
+
+	return findings
+}
+
+// checkContainer detects the OOM kills, the failed exits, the restarts and the failing healthchecks of the container.
+func checkContainer(pod, container string, data *define.InspectContainerData) []Finding {
+	if data.State == nil {
+		return nil
+	}
+	state := data.State
+	finding := func(severity Severity, check, message, hint string) Finding {
+		return Finding{Severity: severity, Pod: pod, Container: container, Check: check, Message: message, Hint: hint}
+	}
+
+	var findings []Finding
+	if state.OOMKilled {
+		findings = append(findings, finding(SeverityCritical, "oom-killed", "the container was killed as it ran out of memory",
+			"Increase the memory limit of the container or the memory assigned to the LPAR, or lower the batch size of the model"))
+	}
+
+	if !state.Running && state.ExitCode != 0 && !state.OOMKilled {
+		message, hint := exitCodeHint(state.ExitCode)
+		findings = append(findings, finding(SeverityError, "exit-code", message, hint))
+	}
+
+	if state.Error != "" {
+		findings = append(findings, finding(SeverityError, "start-error", "the container failed to start: "+state.Error,
+			"Inspect the error above, then restart the application using `ai-services application start`"))
+	}
+
+	if data.RestartCount > 0 {
+		findings = append(findings, finding(SeverityWarning, "restarts", fmt.Sprintf("the container restarted %d time(s)", data.RestartCount),
+			"Inspect the container logs using `ai-services application logs` for the cause of the restarts"))
+	}
+
+	if health := state.Health; health != nil && health.Status == define.HealthCheckUnhealthy {
+		message := fmt.Sprintf("the healthcheck failed %d time(s) in a row", health.FailingStreak)
+		if len(health.Log) > 0 {
+			last := health.Log[len(health.Log)-1]
+			message += fmt.Sprintf(", last exit code %d: %s", last.ExitCode, strings.TrimSpace(last.Output))
+		}
+		findings = append(findings, finding(SeverityError, "healthcheck", message,
+			"Inspect the container logs using `ai-services application logs`, the service may still be loading the model"))
+	}
+
+	return findings
+}
+
+// exitCodeHint describes the exit code along with the hint to remediate it.
+func exitCodeHint(code int32) (string, string) {
+	message := fmt.Sprintf("the container exited with code %d", code)
+	switch code {
+	case exitCodeKilled:
+		return message + " (killed)", "The container was killed, Eg:- by the OOM killer of the host. Verify the memory available on the LPAR"
+	case exitCodeSegfault:
+		return message + " (segmentation fault)", "Inspect the container logs and verify the image is built for the host architecture"
+	case exitCodeTerminated:
+		return message + " (terminated)", "The container was stopped, start it again using `ai-services application start`"
+	case exitCodeNotExecutable, exitCodeNotFound:
+		return message + " (command not found)", "Verify the image of the container and its command"
+	default:
+		return message, "Inspect the container logs using `ai-services application logs`"
+	}
+}
+
+// checkDevices detects the devices of the container missing on the host, such as the vfio devices of the Spyre cards.
+func checkDevices(h *host.Host, pod, container string, data *define.InspectContainerData) []Finding {
+	if data.HostConfig == nil {
+		return nil
+	}
+
+	var findings []Finding
+	for _, device := range data.HostConfig.Devices {
+		if !strings.HasPrefix(device.PathOnHost, vfioDevicePath) {
+			continue
+		}
+		if _, err := h.FS.Stat(device.PathOnHost); err == nil {
+			continue
+		}
+		findings = append(findings, Finding{
+			Severity: SeverityCritical, Pod: pod, Container: container, Check: "vfio-device",
+			Message: fmt.Sprintf("the device %s is missing on the host", device.PathOnHost),
+			Hint:    "Bind the Spyre cards to vfio-pci using `ai-services bootstrap configure`, then restart the application",
+		})
+	}
+
+	return findings
+}
+
+// checkPodPorts detects the host ports of a pod not running which are in use by other processes.
+func checkPodPorts(pod string, data *define.InspectPodData) []Finding {
+	if data.State == define.PodStateRunning || data.InfraConfig == nil {
+		return nil
+	}
+
+	var published []int
+	for _, bindings := range data.InfraConfig.PortBindings {
+		for _, binding := range bindings {
+			if port, err := strconv.Atoi(binding.HostPort); err == nil {
+				published = append(published, port)
+			}
+		}
+	}
+
+	var findings []Finding
+	for _, port := range ports.BusyPorts(published) {
+		findings = append(findings, Finding{
+			Severity: SeverityError, Pod: pod, Check: "port-conflict",
+			Message: fmt.Sprintf("the host port %d published by the pod is in use by another process", port),
+			Hint:    "Stop the process using the port (see `ss -ltnp`) or override the port using --params",
+		})
+	}
+
+	return findings
+}
+
+// checkLogs scans the end of the container logs for the known startup errors.
+func checkLogs(ctx context.Context, rt runtime.Runtime, pod, container, id string) []Finding {
+	logs := utils.NewTailBuffer(logTailSize)
+	if err := rt.FetchContainerLogs(ctx, id, logs); err != nil {
+		logger.Infof("failed to fetch logs of container %s: %v\n", container, err, logger.VerbosityLevelDebug)
+
+		return nil
+	}
+
+	var findings []Finding
+	for _, signature := range logSignatures {
+		if signature.pattern.Match(logs.Bytes()) {
+			findings = append(findings, Finding{
+				Severity: signature.severity, Pod: pod, Container: container, Check: "logs",
+				Message: "logs: " + signature.message, Hint: signature.hint,
+			})
+		}
+	}
+
+	return findings
+}
+
+// checkModels detects the models of the application missing under the model directory.
+func checkModels(h *host.Host, template, app string) []Finding {
+	if template == "" {
+		return nil
+	}
+
+	models, err := helpers.ListModels(template, app)
+	if err != nil {
+		logger.Infof("failed to list models of template %s: %v\n", template, err, logger.VerbosityLevelDebug)
+
+		return nil
+	}
+
+	var findings []Finding
+	for _, model := range models {
+		dir := filepath.Join(vars.ModelDirectory, model.Repo)
+		if entries, err := h.FS.ReadDir(dir); err == nil && len(entries) > 0 {
+			continue
+		} else if err != nil && !os.IsNotExist(err) {
+			logger.Infof("failed to read model directory %s: %v\n", dir, err, logger.VerbosityLevelDebug)
+		}
+		findings = append(findings, Finding{
+			Severity: SeverityCritical, Check: "model",
+			Message: fmt.Sprintf("the model %s is missing under %s", model.Repo, vars.ModelDirectory),
+			Hint:    fmt.Sprintf("Download the model using `ai-services application model download --template %s`", template),
+		})
+	}
+
+	return findings
+}
+
+func isHealthy(data *define.InspectContainerData) bool {
+	if data.State == nil || !data.State.Running {
+		return false
+	}
+
+	return data.State.Health == nil || data.State.Health.Status != define.HealthCheckUnhealthy
+}
@@ -0,0 +1,177 @@
+package doctor
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/containers/podman/v5/libpod/define"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+)
+
+// fakeLogs serves the logs of the containers, the other calls of the runtime are not expected.
+type fakeLogs struct {
+	runtime.Runtime
+	logs string
+	err  error
+}
+
+func (f fakeLogs) FetchContainerLogs(_ context.Context, _ string, w io.Writer) error {
+	if f.err != nil {
+		return f.err
+	}
+	_, err := io.WriteString(w, f.logs)
+
+	return err
+}
+
+// checks returns the checks of the findings in order.
+func checks(findings []Finding) []string {
+	names := make([]string, 0, len(findings))
+	for _, f := range findings {
+		names = append(names, f.Check)
+	}
+
+	return names
+}
+
+func TestCheckContainer(t *testing.T) {
+	tests := map[string]struct {
+		data       define.InspectContainerData
+		wantChecks []string
+	}{
+		"running": {
+			data: define.InspectContainerData{State: &define.InspectContainerState{Running: true}},
+		},
+		"oom killed reported once": {
+			data:       define.InspectContainerData{State: &define.InspectContainerState{OOMKilled: true, ExitCode: 137}},
+			wantChecks: []string{"oom-killed"},
+		},
+		"exited with an error": {
+			data:       define.InspectContainerData{State: &define.InspectContainerState{ExitCode: 1}},
+			wantChecks: []string{"exit-code"},
+		},
+		"failed to start": {
+			data:       define.InspectContainerData{State: &define.InspectContainerState{ExitCode: 127, Error: "executable file not found"}},
+			wantChecks: []string{"exit-code", "start-error"},
+		},
+		"restarted and unhealthy": {
+			data: define.InspectContainerData{
+				RestartCount: 3,
+				State: &define.InspectContainerState{Running: true, Health: &define.HealthCheckResults{
+					Status: define.HealthCheckUnhealthy, FailingStreak: 5,
+					Log: []define.HealthCheckLog{{ExitCode: 7, Output: "connection refused\n"}},
+				}},
+			},
+			wantChecks: []string{"restarts", "healthcheck"},
+		},
+		"without a state": {},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			findings := checkContainer("rag--vllm-server", "instruct", &tt.data)
+			if got := checks(findings); !slices.Equal(got, tt.wantChecks) {
+				t.Fatalf("expected the checks %v, got %v", tt.wantChecks, got)
+			}
+			for _, f := range findings {
+				if f.Pod != "rag--vllm-server" || f.Container != "instruct" || f.Hint == "" {
+					t.Errorf("expected the finding to name the container along with a hint, got %+v", f)
+				}
+			}
+		})
+	}
+
+	unhealthy := tests["restarted and unhealthy"].data
+	if message := checkContainer("pod", "ctr", &unhealthy)[1].Message; !strings.Contains(message, "last exit code 7: connection refused") {
+		t.Errorf("expected the last healthcheck to be reported, got '%s'", message)
+	}
+}
+
+func TestExitCodeHint(t *testing.T) {
+	tests := []struct {
+		code        int32
+		wantMessage string
+	}{
+		{1, "the container exited with code 1"},
+		{126, "the container exited with code 126 (command not found)"},
+		{127, "the container exited with code 127 (command not found)"},
+		{137, "the container exited with code 137 (killed)"},
+		{139, "the container exited with code 139 (segmentation fault)"},
+		{143, "the container exited with code 143 (terminated)"},
+	}
+
+	for _, tt := range tests {
+		message, hint := exitCodeHint(tt.code)
+		if message != tt.wantMessage || hint == "" {
+			t.Errorf("exit code %d: expected '%s' along with a hint, got '%s' with hint '%s'", tt.code, tt.wantMessage, message, hint)
+		}
+	}
+}
+
+func TestCheckLogs(t *testing.T) {
+	tests := map[string]struct {
+		logs        string
+		wantMessage string
+	}{
+		"torch out of memory": {
+			logs:        "torch.OutOfMemoryError: Tried to allocate 2.00 GiB",
+			wantMessage: "the model ran out of memory while loading",
+		},
+		"runtime out of memory": {
+			logs:        "RuntimeError: [enforce fail at alloc_cpu.cpp:117] err == 0. DefaultCPUAllocator: can't allocate memory: you tried to allocate 1073741824 bytes. Error code 12 (Cannot allocate memory), out of memory",
+			wantMessage: "the model ran out of memory while loading",
+		},
+		"context exceeding the model": {
+			logs:        "ValueError: User-specified max_model_len (8192) is greater than the derived max_model_len (max_position_embeddings=4096)",
+			wantMessage: "the max model length exceeds the length supported by the model",
+		},
+		"port in use": {
+			logs:        "OSError: [Errno 98] error while attempting to bind on address ('0.0.0.0', 8000): address already in use",
+			wantMessage: "a port required by the container is in use",
+		},
+		"python permission denied": {
+			logs:        "PermissionError: [Errno 13] Permission denied: '/models/ibm-granite/config.json'",
+			wantMessage: "the container was denied access to a file or device",
+		},
+		"vfio permission denied": {
+			logs:        "failed to open /dev/vfio/12: Permission denied",
+			wantMessage: "the container was denied access to a file or device",
+		},
+		"oci runtime permission denied": {
+			logs:        "crun: open `/var/lib/containers/storage/overlay/l/ABC`: Permission denied: OCI permission denied",
+			wantMessage: "the container was denied access to a file or device",
+		},
+		// the lines merely mentioning the errors are not reported
+		"benign mentions": {
+			logs: "INFO: retrying on out of memory is disabled\n" +
+				"WARNING: Permission denied is returned by the metrics endpoint for anonymous users\n" +
+				"INFO: the server logs 'Address already in use' when the port is taken\n",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			findings := checkLogs(t.Context(), fakeLogs{logs: tt.logs}, "rag--vllm-server", "instruct", "0123")
+
+			var messages []string
+			for _, f := range findings {
+				messages = append(messages, strings.TrimPrefix(f.Message, "logs: "))
+			}
+			switch {
+			case tt.wantMessage == "" && len(messages) > 0:
+				t.Errorf("expected no findings, got %v", messages)
+			case tt.wantMessage != "" && !slices.Equal(messages, []string{tt.wantMessage}):
+				t.Errorf("expected the finding '%s', got %v", tt.wantMessage, messages)
+			}
+		})
+	}
+
+	if findings := checkLogs(t.Context(), fakeLogs{err: errors.New("no such container")}, "pod", "ctr", "0123"); findings != nil {
+		t.Errorf("expected no findings without the logs, got %+v", findings)
+	}
+}
@@ -0,0 +1,210 @@
+package hf
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+const (
+	// DefaultEndpoint is the Hugging Face Hub endpoint used unless overridden by HF_ENDPOINT.
+	DefaultEndpoint = "https://huggingface.co"
+	// DefaultRevision is the revision downloaded unless a revision is given.
+	DefaultRevision = "main"
+	// DefaultConcurrency is the number of files downloaded in parallel.
+	DefaultConcurrency = 4
+
+	// EnvEndpoint overrides the Hugging Face Hub endpoint, Eg:- a mirror or a local stand-in server.
+	EnvEndpoint = "HF_ENDPOINT"
+	// EnvToken is the access token used for the private and the gated repositories.
+	EnvToken = "HF_TOKEN"
+
+	userAgent = "ai-services"
+	// errorBodyLimit is the size of the response body read for the error message.
+	errorBodyLimit = 1024
+)
+
+// Client is a client of the Hugging Face Hub.
+type Client struct {
+	// Endpoint is the URL of the Hugging Face Hub, Eg:- https://huggingface.co
+	Endpoint string
+	// Token is the access token sent as a bearer token, not sent if empty
+	Token string
+	// Concurrency is the number of files downloaded in parallel
+	Concurrency int
+	HTTPClient  *http.Client
+}
+
+// NewClient returns a client of the Hugging Face Hub honoring the HF_ENDPOINT and HF_TOKEN env vars.
+func NewClient() *Client {
+	endpoint := DefaultEndpoint
+	if value := os.Getenv(EnvEndpoint); value != "" {
+		endpoint = value
+	}
+
+	return &Client{
+		Endpoint:    strings.TrimSuffix(endpoint, "/"),
+		Token:       os.Getenv(EnvToken),
+		Concurrency: DefaultConcurrency,
+		HTTPClient:  &http.Client{},
+	}
+}
+
+// RepoInfo is a revision of a model repository along with its files.
+type RepoInfo struct {
+	// SHA is the commit the revision resolves to
+	SHA   string `json:"sha"`
+	Files []File `json:"siblings"`
+}
+
+// Size returns the total size of the files in bytes.
+func (r *RepoInfo) Size() int64 {
+	var size int64
+	for _, f := range r.Files {
+		size += f.Size
+	}
+
+	return size
+}
+
+// File is a file of a model repository.
+type File struct {
+	// Path is the path of the file relative to the repository root
+	Path string `json:"rfilename"`
+	Size int64  `json:"size"`
+	// BlobID is the git blob id of the file, it is the etag of the files not stored using LFS
+	BlobID string `json:"blobId"`
+	LFS    *LFS   `json:"lfs,omitempty"`
+}
+
+// LFS holds the details of a file stored using LFS, Eg:- the model weights.
+type LFS struct {
+	SHA256 string `json:"sha256"`
+	Size   int64  `json:"size"`
+}
+
+// ETag returns the etag of the file served by the hub, the sha256 of the LFS files and the git blob id of the others.
+func (f File) ETag() string {
+	if f.LFS != nil {
+		return f.LFS.SHA256
+	}
+
+	return f.BlobID
+}
+
+// Revision resolves the revision of the model repository, Eg:- a branch, a tag or a commit, and lists its files.
+func (c *Client) Revision(ctx context.Context, repo, revision string) (*RepoInfo, error) {
+	if revision == "" {
+		revision = DefaultRevision
+	}
+
+	endpoint := fmt.Sprintf("%s/api/models/%s/revision/%s?blobs=true", c.Endpoint, repo, url.PathEscape(revision))
+	resp, err := c.get(ctx, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponse(resp, repo, revision); err != nil {
+		return nil, err
+	}
+
+	info := &RepoInfo{}
+	if err := json.NewDecoder(resp.Body).Decode(info); err != nil {
+		return nil, fmt.Errorf("failed to decode the revision %s of %s: %w", revision, repo, err)
+	}
+	if info.SHA == "" {
+		return nil, fmt.Errorf("failed to resolve the revision %s of %s: the commit is missing in the response", revision, repo)
+	}
+	for i, f := range info.Files {
+		if f.LFS != nil && f.Size == 0 {
+			info.Files[i].Size = f.LFS.Size
+		}
+	}
+
+	return info, nil
+}
+
+// ReadFile returns the content of the file of the model repository at the commit, Eg:- config.json.
+func (c *Client) ReadFile(ctx context.Context, repo, commit, path string) ([]byte, error) {
+	resp, err := c.get(ctx, c.fileURL(repo, commit, path), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponse(resp, repo, commit); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s of %s: %w", path, repo, err)
+	}
+
+	return data, nil
+}
+
+// fileURL returns the URL serving the file of the model repository at the commit.
+func (c *Client) fileURL(repo, commit, path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return fmt.Sprintf("%s/%s/resolve/%s/%s", c.Endpoint, repo, commit, strings.Join(segments, "/"))
+}
+
+func (c *Client) get(ctx context.Context, endpoint string, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("User-Agent", userAgent)
+	// the token is not forwarded on the redirects to the other hosts, Eg:- the CDN serving the LFS files
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach %s: %w", c.Endpoint, err)
+	}
+
+	return resp, nil
+}
+
+// ErrNotFound is returned when the revision of the repository or one of its files does not exist.
+var ErrNotFound = errors.New("not found")
+
+// checkResponse returns an error describing the failed response.
+func checkResponse(resp *http.Response, repo, revision string) error {
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusPartialContent:
+		return nil
+	case http.StatusUnauthorized:
+		return fmt.Errorf("access to %s is denied: the repository does not exist or is private or gated, set %s to a token with access to it", repo, EnvToken)
+	case http.StatusForbidden:
+		return fmt.Errorf("access to %s is forbidden: request access to the gated repository on the hub and set %s", repo, EnvToken)
+	case http.StatusNotFound:
+		return fmt.Errorf("the revision %s of %s is %w", revision, repo, ErrNotFound)
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, errorBodyLimit))
+
+	return fmt.Errorf("unexpected response from the hub for %s: %s: %s", repo, resp.Status, strings.TrimSpace(string(body)))
+}
@@ -0,0 +1,25 @@
+package hf
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/hf/hftest"
+)
+
+func TestReadFile(t *testing.T) {
+	hub := &hftest.Hub{Files: testFiles()}
+	client := testClient(hub.Start(t))
+
+	data, err := client.ReadFile(t.Context(), hftest.Repo, hftest.Commit, "config.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != string(hub.Files["config.json"]) {
+		t.Errorf("unexpected content %s", data)
+	}
+
+	if _, err := client.ReadFile(t.Context(), hftest.Repo, hftest.Commit, "missing.json"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected the missing file to be not found, got: %v", err)
+	}
+}
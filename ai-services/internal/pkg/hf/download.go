@@ -0,0 +1,321 @@
+package hf
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1" //nolint:gosec // the git blob id of the files is a sha1
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// MetadataDir is the directory within the model directory holding the metadata of the downloaded files along
+	// with the incomplete downloads. The files are named as the hf cli names them so that either can resume the
+	// other, Eg:- model.safetensors.metadata and <short hash of model.safetensors.metadata>.<etag>.incomplete.
+	MetadataDir = ".cache/huggingface/download"
+
+	// MetadataSuffix is the suffix of the metadata of a downloaded file within MetadataDir.
+	MetadataSuffix = ".metadata"
+
+	incompleteSuffix = ".incomplete"
+	dirPermissions   = 0o755
+	filePermissions  = 0o644
+	// metadataLines is the number of lines of a metadata file: the commit, the etag and the download timestamp.
+	metadataLines = 3
+)
+
+// Progress is called with the bytes downloaded so far out of the total size of the model.
+// It is called from a single goroutine at a time.
+type Progress func(done, total int64)
+
+// Download downloads the files of the revision of the model repository into dir. The files already downloaded are
+// skipped and the incomplete downloads are resumed. Each file is verified against its sha256 or git blob id and moved
+// into place once complete. Returns the resolved revision.
+func (c *Client) Download(ctx context.Context, repo, revision, dir string, progress Progress) (*RepoInfo, error) {
+	info, err := c.Revision(ctx, repo, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range info.Files {
+		if !filepath.IsLocal(f.Path) {
+			return nil, fmt.Errorf("invalid file path '%s' in %s", f.Path, repo)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	tracker := &tracker{total: info.Size(), progress: progress}
+	files := make(chan File)
+	errCh := make(chan error, len(info.Files))
+
+	concurrency := max(c.Concurrency, 1)
+	var wg sync.WaitGroup
+	for range concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for f := range files {
+				if err := c.downloadFile(ctx, repo, info.SHA, dir, f, tracker); err != nil {
+					errCh <- fmt.Errorf("failed to download %s: %w", f.Path, err)
+					// stop the other downloads, their progress is kept to resume later
+					cancel()
+				}
+			}
+		}()
+	}
+
+	for _, f := range info.Files {
+		if ctx.Err() != nil {
+			break
+		}
+		files <- f
+	}
+	close(files)
+	wg.Wait()
+	close(errCh)
+
+	var errs []error
+	var canceled error
+	for e := range errCh {
+		// the downloads cancelled due to another failure are not reported
+		if errors.Is(e, context.Canceled) {
+			canceled = e
+
+			continue
+		}
+		errs = append(errs, e)
+	}
+	if len(errs) == 0 && canceled != nil {
+		errs = append(errs, canceled)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return info, nil
+}
+
+// downloadFile downloads the file into dir unless it is already downloaded, resuming its incomplete download if any.
+func (c *Client) downloadFile(ctx context.Context, repo, commit, dir string, f File, t *tracker) error {
+	target := filepath.Join(dir, filepath.FromSlash(f.Path))
+	metadata := filepath.Join(dir, MetadataDir, filepath.FromSlash(f.Path)+MetadataSuffix)
+
+	if isDownloaded(target, metadata, f) {
+		t.add(f.Size)
+
+		return nil
+	}
+
+	partial := incompletePath(metadata, f.ETag())
+	if err := os.MkdirAll(filepath.Dir(partial), dirPermissions); err != nil {
+		return fmt.Errorf("failed to create download directory: %w", err)
+	}
+
+	file, err := os.OpenFile(partial, os.O_CREATE|os.O_RDWR, filePermissions)
+	if err != nil {
+		return fmt.Errorf("failed to open incomplete download: %w", err)
+	}
+	defer file.Close()
+
+	if err := c.fetch(ctx, repo, commit, f, file, t); err != nil {
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", partial, err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", partial, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(target), dirPermissions); err != nil {
+		return fmt.Errorf("failed to create model directory: %w", err)
+	}
+	if err := os.Rename(partial, target); err != nil {
+		return fmt.Errorf("failed to move %s into place: %w", f.Path, err)
+	}
+
+	return writeMetadata(metadata, commit, f.ETag())
+}
+
+// incompletePath returns the path of the incomplete download of the file with the given metadata and etag.
+// The etag is part of the name, hence a download is never resumed from the content of another version of the file.
+func incompletePath(metadata, etag string) string {
+	sum := sha1.Sum([]byte(filepath.Base(metadata))) //nolint:gosec // the short hash of the hf cli is a sha1
+
+	return filepath.Join(filepath.Dir(metadata), base64.URLEncoding.EncodeToString(sum[:])+"."+etag+incompleteSuffix)
+}
+
+// fetch downloads the rest of the file into the incomplete download and verifies its checksum.
+func (c *Client) fetch(ctx context.Context, repo, commit string, f File, file *os.File, t *tracker) error {
+	h := newHasher(f)
+
+	// hash the bytes already downloaded to verify the whole file once complete
+	offset, err := io.Copy(h, file)
+	if err != nil {
+		return fmt.Errorf("failed to read incomplete download: %w", err)
+	}
+	if offset > f.Size {
+		offset, h = 0, newHasher(f)
+		if err := file.Truncate(0); err != nil {
+			return fmt.Errorf("failed to reset incomplete download: %w", err)
+		}
+	}
+	t.add(offset)
+
+	if offset < f.Size {
+		header := http.Header{}
+		if offset > 0 {
+			header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		}
+
+		resp, err := c.get(ctx, c.fileURL(repo, commit, f.Path), header)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		if err := checkResponse(resp, repo, commit); err != nil {
+			return err
+		}
+
+		// the range is ignored by the server, the file is downloaded from the start
+		if offset > 0 && resp.StatusCode != http.StatusPartialContent {
+			t.add(-offset)
+			offset, h = 0, newHasher(f)
+		}
+		if err := file.Truncate(offset); err != nil {
+			return fmt.Errorf("failed to write incomplete download: %w", err)
+		}
+		if _, err := file.Seek(offset, io.SeekStart); err != nil {
+			return fmt.Errorf("failed to write incomplete download: %w", err)
+		}
+
+		written, err := io.Copy(io.MultiWriter(file, h, t), resp.Body)
+		if err != nil {
+			return fmt.Errorf("failed to download: %w", err)
+		}
+		offset += written
+	}
+
+	if offset != f.Size {
+		return fmt.Errorf("size mismatch: expected %d bytes, got %d", f.Size, offset)
+	}
+
+	if sum := hex.EncodeToString(h.Sum(nil)); f.ETag() != "" && sum != f.ETag() {
+		// the incomplete download is corrupted, it is discarded to be downloaded again
+		_ = file.Truncate(0)
+
+		return fmt.Errorf("checksum mismatch: expected %s, got %s", f.ETag(), sum)
+	}
+
+	return nil
+}
+
+// Checksum returns the checksum of the content of the file matching its etag.
+func (f File) Checksum(r io.Reader) (string, error) {
+	h := newHasher(f)
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// newHasher returns the hash matching the etag of the file, the sha256 for the LFS files and the git blob id for the
+// others.
+func newHasher(f File) hash.Hash {
+	if f.LFS != nil {
+		return sha256.New()
+	}
+
+	h := sha1.New() //nolint:gosec // the git blob id of the files is a sha1
+	fmt.Fprintf(h, "blob %d\x00", f.Size)
+
+	return h
+}
+
+// isDownloaded reports whether the file is already downloaded, its metadata recording the same etag.
+func isDownloaded(target, metadata string, f File) bool {
+	stat, err := os.Stat(target)
+	if err != nil || stat.Size() != f.Size {
+		return false
+	}
+
+	_, etag, err := ReadMetadata(metadata)
+
+	return err == nil && etag == f.ETag()
+}
+
+// ReadMetadata returns the commit and the etag recorded for a downloaded file.
+func ReadMetadata(path string) (string, string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", "", err
+	}
+	defer file.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() && len(lines) < metadataLines {
+		lines = append(lines, strings.TrimSpace(scanner.Text()))
+	}
+	if err := scanner.Err(); err != nil {
+		return "", "", err
+	}
+	if len(lines) < metadataLines-1 {
+		return "", "", fmt.Errorf("invalid metadata file %s", path)
+	}
+
+	return lines[0], lines[1], nil
+}
+
+// writeMetadata records the commit and the etag of the downloaded file.
+func writeMetadata(path, commit, etag string) error {
+	content := strings.Join([]string{commit, etag, strconv.FormatInt(time.Now().Unix(), 10)}, "\n") + "\n"
+	if err := os.WriteFile(path, []byte(content), filePermissions); err != nil {
+		return fmt.Errorf("failed to write download metadata: %w", err)
+	}
+
+	return nil
+}
+
+// tracker sums the bytes downloaded by the parallel downloads and reports the progress.
+type tracker struct {
+	mu       sync.Mutex
+	done     atomic.Int64
+	total    int64
+	progress Progress
+}
+
+func (t *tracker) Write(p []byte) (int, error) {
+	t.add(int64(len(p)))
+
+	return len(p), nil
+}
+
+func (t *tracker) add(n int64) {
+	done := t.done.Add(n)
+	if t.progress == nil || n == 0 {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.progress(done, t.total)
+}
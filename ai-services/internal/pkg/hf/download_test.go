@@ -0,0 +1,236 @@
+package hf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/hf/hftest"
+)
+
+func testClient(endpoint string) *Client {
+	return &Client{Endpoint: endpoint, Concurrency: DefaultConcurrency, HTTPClient: &http.Client{}}
+}
+
+func testFiles() map[string][]byte {
+	return map[string][]byte{
+		"config.json":                      []byte(`{"max_position_embeddings": 4096}`),
+		"model-00001-of-00002.safetensors": bytes.Repeat([]byte("weights-1 "), 1000),
+		"nested/model-00002.safetensors":   bytes.Repeat([]byte("weights-2 "), 500),
+	}
+}
+
+// assertDownloaded fails the test unless the files are downloaded into dir along with their metadata.
+func assertDownloaded(t *testing.T, dir string, files map[string][]byte) {
+	t.Helper()
+
+	for path, data := range files {
+		got, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(path)))
+		if err != nil {
+			t.Fatalf("%s is not downloaded: %v", path, err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Errorf("%s: unexpected content", path)
+		}
+
+		commit, etag, err := ReadMetadata(filepath.Join(dir, MetadataDir, filepath.FromSlash(path)+MetadataSuffix))
+		if err != nil {
+			t.Fatalf("%s: failed to read metadata: %v", path, err)
+		}
+		if commit != hftest.Commit || etag != hftest.ETag(path, data) {
+			t.Errorf("%s: unexpected metadata %s %s", path, commit, etag)
+		}
+	}
+
+	incomplete, _ := filepath.Glob(filepath.Join(dir, MetadataDir, "*"+incompleteSuffix))
+	if len(incomplete) > 0 {
+		t.Errorf("expected no incomplete downloads left, got %v", incomplete)
+	}
+}
+
+func TestDownload(t *testing.T) {
+	hub := &hftest.Hub{Files: testFiles()}
+	endpoint := hub.Start(t)
+	dir := t.TempDir()
+
+	var done, total int64
+	info, err := testClient(endpoint).Download(t.Context(), hftest.Repo, "", dir, func(d, tot int64) { done, total = d, tot })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.SHA != hftest.Commit {
+		t.Errorf("expected the revision to resolve to %s, got %s", hftest.Commit, info.SHA)
+	}
+	if done != total || total != info.Size() {
+		t.Errorf("expected the progress to reach %d bytes, got %d of %d", info.Size(), done, total)
+	}
+	assertDownloaded(t, dir, hub.Files)
+	if requests := hub.FileRequests(); len(requests) != len(hub.Files) {
+		t.Errorf("expected a request per file, got %v", requests)
+	}
+
+	// the files already downloaded are skipped
+	hub.ResetRequests()
+	if _, err := testClient(endpoint).Download(t.Context(), hftest.Repo, "", dir, nil); err != nil {
+		t.Fatal(err)
+	}
+	if requests := hub.FileRequests(); len(requests) != 0 {
+		t.Errorf("expected the downloaded files to be skipped, got requests for %v", requests)
+	}
+}
+
+func TestDownloadResume(t *testing.T) {
+	const path = "model-00001-of-00002.safetensors"
+
+	tests := []struct {
+		name        string
+		ignoreRange bool
+	}{
+		{name: "range answered with 206"},
+		{name: "range ignored and answered with 200", ignoreRange: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hub := &hftest.Hub{Files: testFiles(), IgnoreRange: tt.ignoreRange}
+			endpoint := hub.Start(t)
+			dir := t.TempDir()
+
+			data := hub.Files[path]
+			offset := len(data) / 2
+			metadata := filepath.Join(dir, MetadataDir, path+MetadataSuffix)
+			partial := incompletePath(metadata, hftest.ETag(path, data))
+			if err := os.MkdirAll(filepath.Dir(partial), dirPermissions); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(partial, data[:offset], filePermissions); err != nil {
+				t.Fatal(err)
+			}
+
+			var done int64
+			info, err := testClient(endpoint).Download(t.Context(), hftest.Repo, "", dir, func(d, _ int64) { done = d })
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertDownloaded(t, dir, hub.Files)
+			if done != info.Size() {
+				t.Errorf("expected the progress to reach %d bytes, got %d", info.Size(), done)
+			}
+			if got, want := hub.FileRequests()[path], fmt.Sprintf("bytes=%d-", offset); got != want {
+				t.Errorf("expected the range %s to be requested, got '%s'", want, got)
+			}
+		})
+	}
+}
+
+func TestDownloadChecksumMismatch(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+	}{
+		{name: "sha256 of an LFS file", path: "model-00001-of-00002.safetensors"},
+		{name: "git blob id of a regular file", path: "config.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := testFiles()
+			// the corrupted content keeps the size of the file
+			corrupted := bytes.ToUpper(files[tt.path])
+			hub := &hftest.Hub{Files: files, Served: map[string][]byte{tt.path: corrupted}}
+			endpoint := hub.Start(t)
+			dir := t.TempDir()
+
+			_, err := testClient(endpoint).Download(t.Context(), hftest.Repo, "", dir, nil)
+			if err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+				t.Fatalf("expected a checksum mismatch, got: %v", err)
+			}
+			if _, err := os.Stat(filepath.Join(dir, tt.path)); !os.IsNotExist(err) {
+				t.Errorf("expected the corrupted file not to be moved into place, got: %v", err)
+			}
+
+			// the corrupted download is discarded, hence downloaded again once served correctly
+			hub.Served = nil
+			if _, err := testClient(endpoint).Download(t.Context(), hftest.Repo, "", dir, nil); err != nil {
+				t.Fatal(err)
+			}
+			assertDownloaded(t, dir, files)
+		})
+	}
+}
+
+func TestDownloadErrorStatus(t *testing.T) {
+	tests := []struct {
+		status  int
+		wantErr string
+		// notFound reports whether the error is expected to be ErrNotFound
+		notFound bool
+	}{
+		{status: http.StatusUnauthorized, wantErr: "access to " + hftest.Repo + " is denied"},
+		{status: http.StatusForbidden, wantErr: "access to " + hftest.Repo + " is forbidden"},
+		{status: http.StatusNotFound, wantErr: "the revision main of " + hftest.Repo + " is not found", notFound: true},
+		{status: http.StatusInternalServerError, wantErr: "unexpected response from the hub"},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			endpoint := (&hftest.Hub{Status: tt.status}).Start(t)
+
+			_, err := testClient(endpoint).Download(t.Context(), hftest.Repo, "", t.TempDir(), nil)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected the error to contain '%s', got: %v", tt.wantErr, err)
+			}
+			if errors.Is(err, ErrNotFound) != tt.notFound {
+				t.Errorf("expected errors.Is(err, ErrNotFound) to be %t, got: %v", tt.notFound, err)
+			}
+		})
+	}
+}
+
+func TestNewClientHonorsEnv(t *testing.T) {
+	hub := &hftest.Hub{Files: testFiles()}
+	endpoint := hub.Start(t)
+	t.Setenv(EnvEndpoint, endpoint+"/")
+	t.Setenv(EnvToken, "hf_test")
+
+	client := NewClient()
+	if client.Endpoint != endpoint {
+		t.Errorf("expected the endpoint %s, got %s", endpoint, client.Endpoint)
+	}
+	if _, err := client.Download(t.Context(), hftest.Repo, "", t.TempDir(), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, r := range hub.Requests() {
+		if got := r.Header.Get("Authorization"); got != "Bearer hf_test" {
+			t.Errorf("%s: expected the token to be sent, got '%s'", r.URL.Path, got)
+		}
+	}
+}
+
+func TestDownloadRejectsPathTraversal(t *testing.T) {
+	for _, path := range []string{"../outside.json", "nested/../../outside.json", "/etc/outside.json"} {
+		t.Run(path, func(t *testing.T) {
+			hub := &hftest.Hub{Files: testFiles(), Sizes: map[string]int64{path: 1}}
+			endpoint := hub.Start(t)
+			root := t.TempDir()
+			dir := filepath.Join(root, "model")
+
+			_, err := testClient(endpoint).Download(t.Context(), hftest.Repo, "", dir, nil)
+			if err == nil || !strings.Contains(err.Error(), "invalid file path") {
+				t.Fatalf("expected the path to be rejected, got: %v", err)
+			}
+			if requests := hub.FileRequests(); len(requests) != 0 {
+				t.Errorf("expected no file to be downloaded, got requests for %v", requests)
+			}
+			if _, err := os.Stat(dir); !os.IsNotExist(err) {
+				t.Errorf("expected nothing to be written, got: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+// Package hftest provides a stand-in for the Hugging Face Hub serving a single model repository, to test the downloads
+// and the lookups of the models off the network. It is only meant to be imported from the tests.
+package hftest
+
+import (
+	"bytes"
+	"crypto/sha1" //nolint:gosec // the git blob id of the files is a sha1
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	// Repo is the model repository served by the hub.
+	Repo = "ibm-granite/granite-test"
+	// Commit is the commit all the revisions of the repository resolve to.
+	Commit = "0123456789abcdef0123456789abcdef01234567"
+)
+
+// Hub serves the revision of the repository along with its files, the way the hub does.
+type Hub struct {
+	// Files holds the content of the files served keyed by their path
+	Files map[string][]byte
+	// Sizes lists more files of the given sizes without serving them, Eg:- the weights too large to serve or a path
+	// outside the model directory
+	Sizes map[string]int64
+	// Served overrides the content served for the files, Eg:- to corrupt a file
+	Served map[string][]byte
+	// Status is the status answered to all the requests if set, Eg:- 401
+	Status int
+	// IgnoreRange serves the whole file with 200 to the range requests
+	IgnoreRange bool
+
+	mu       sync.Mutex
+	requests []*http.Request
+}
+
+// siblingFile is a file of the revision as listed by the hub.
+type siblingFile struct {
+	Path   string   `json:"rfilename"`
+	Size   int64    `json:"size"`
+	BlobID string   `json:"blobId"`
+	LFS    *lfsFile `json:"lfs,omitempty"`
+}
+
+type lfsFile struct {
+	SHA256 string `json:"sha256"`
+	Size   int64  `json:"size"`
+}
+
+// Start serves the hub until the test completes and returns its endpoint.
+func (h *Hub) Start(t testing.TB) string {
+	t.Helper()
+
+	// the routes conflict within a single mux, Eg:- /api/models/resolve/...
+	api := http.NewServeMux()
+	api.HandleFunc("GET /api/models/{org}/{name}/revision/{revision}", h.serveRevision)
+	files := http.NewServeMux()
+	files.HandleFunc("GET /{org}/{name}/resolve/{commit}/{path...}", h.serveFile)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.mu.Lock()
+		h.requests = append(h.requests, r.Clone(r.Context()))
+		status := h.Status
+		h.mu.Unlock()
+
+		if status != 0 {
+			http.Error(w, http.StatusText(status), status)
+
+			return
+		}
+		if strings.HasPrefix(r.URL.Path, "/api/") {
+			api.ServeHTTP(w, r)
+
+			return
+		}
+		files.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL
+}
+
+// Requests returns the requests served so far.
+func (h *Hub) Requests() []*http.Request {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return append([]*http.Request(nil), h.requests...)
+}
+
+// FileRequests returns the range requested for each of the file requests served so far, empty if the whole file is
+// requested.
+func (h *Hub) FileRequests() map[string]string {
+	requests := map[string]string{}
+	for _, r := range h.Requests() {
+		if _, path, ok := strings.Cut(r.URL.Path, "/resolve/"+Commit+"/"); ok {
+			requests[path] = r.Header.Get("Range")
+		}
+	}
+
+	return requests
+}
+
+// ResetRequests forgets the requests served so far.
+func (h *Hub) ResetRequests() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.requests = nil
+}
+
+func (h *Hub) serveRevision(w http.ResponseWriter, r *http.Request) {
+	if r.PathValue("org")+"/"+r.PathValue("name") != Repo {
+		http.NotFound(w, r)
+
+		return
+	}
+
+	info := struct {
+		SHA   string        `json:"sha"`
+		Files []siblingFile `json:"siblings"`
+	}{SHA: Commit}
+	for path, size := range h.Sizes {
+		info.Files = append(info.Files, siblingFile{Path: path, Size: size})
+	}
+	for path, data := range h.Files {
+		f := siblingFile{Path: path, Size: int64(len(data))}
+		if isLFS(path) {
+			f.LFS = &lfsFile{SHA256: ETag(path, data), Size: f.Size}
+		} else {
+			f.BlobID = ETag(path, data)
+		}
+		info.Files = append(info.Files, f)
+	}
+
+	_ = json.NewEncoder(w).Encode(info)
+}
+
+func (h *Hub) serveFile(w http.ResponseWriter, r *http.Request) {
+	data, ok := h.Served[r.PathValue("path")]
+	if !ok {
+		data, ok = h.Files[r.PathValue("path")]
+	}
+	if !ok || r.PathValue("commit") != Commit {
+		http.NotFound(w, r)
+
+		return
+	}
+
+	if h.IgnoreRange {
+		_, _ = w.Write(data)
+
+		return
+	}
+	http.ServeContent(w, r, r.PathValue("path"), time.Time{}, bytes.NewReader(data))
+}
+
+// ETag returns the etag the hub serves the file with, the sha256 of the files stored using LFS, Eg:- the safetensors
+// files, and the git blob id of the others.
+func ETag(path string, data []byte) string {
+	if isLFS(path) {
+		sum := sha256.Sum256(data)
+
+		return hex.EncodeToString(sum[:])
+	}
+
+	h := sha1.New() //nolint:gosec // the git blob id of the files is a sha1
+	fmt.Fprintf(h, "blob %d\x00", len(data))
+	h.Write(data)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func isLFS(path string) bool {
+	return strings.HasSuffix(path, ".safetensors")
+}
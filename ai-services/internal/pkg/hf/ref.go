@@ -0,0 +1,57 @@
+package hf
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// revisionSeparator separates the repository from its revision within a reference, Eg:- repo@revision.
+const revisionSeparator = "@"
+
+// commitPattern matches the full commits, the only revisions which cannot move.
+var commitPattern = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// Ref is a reference to a revision of a model repository, Eg:- ibm-granite/granite-3.3-8b-instruct@<commit>.
+type Ref struct {
+	Repo string `json:"repo"`
+	// Revision is a commit, a branch or a tag, the default revision if empty
+	Revision string `json:"revision,omitempty"`
+}
+
+// ParseRef parses the reference to the model repository in the form repo[@revision].
+func ParseRef(value string) (Ref, error) {
+	repo, revision, found := strings.Cut(strings.TrimSpace(value), revisionSeparator)
+	if repo == "" || !filepath.IsLocal(filepath.FromSlash(repo)) {
+		return Ref{}, fmt.Errorf("invalid model '%s': the repository must be in the form <organization>/<name>", value)
+	}
+	if found && revision == "" {
+		return Ref{}, fmt.Errorf("invalid model '%s': the revision after '%s' is empty", value, revisionSeparator)
+	}
+
+	return Ref{Repo: repo, Revision: revision}, nil
+}
+
+// String returns the reference in the form repo[@revision].
+func (r Ref) String() string {
+	if r.Revision == "" {
+		return r.Repo
+	}
+
+	return r.Repo + revisionSeparator + r.Revision
+}
+
+// RevisionOrDefault returns the revision of the reference, the default revision if not set.
+func (r Ref) RevisionOrDefault() string {
+	if r.Revision == "" {
+		return DefaultRevision
+	}
+
+	return r.Revision
+}
+
+// IsPinned reports whether the reference is pinned to a commit, unlike a branch or a tag which can move.
+func (r Ref) IsPinned() bool {
+	return commitPattern.MatchString(r.Revision)
+}
@@ -0,0 +1,89 @@
+package host
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"os/exec"
+	"runtime"
+	"syscall"
+	"time"
+)
+
+// killDelay is the time given to a command to exit once terminated, before it is killed.
+const killDelay = 10 * time.Second
+
+// CommandRunner runs commands on the host. The commands are killed once the context is done, Eg:- when the validation
+// running them times out.
+type CommandRunner interface {
+	// Run runs the command and returns its combined output.
+	Run(ctx context.Context, name string, args ...string) ([]byte, error)
+	// LookPath searches for the executable in the PATH.
+	LookPath(file string) (string, error)
+}
+
+// FS provides read access to the host filesystem using absolute paths.
+type FS interface {
+	ReadFile(name string) ([]byte, error)
+	ReadDir(name string) ([]fs.DirEntry, error)
+	Stat(name string) (fs.FileInfo, error)
+	// Statfs returns the statistics of the filesystem holding the path.
+	Statfs(path string, buf *syscall.Statfs_t) error
+}
+
+// Host is the machine the validations and the configurations are run against.
+type Host struct {
+	Runner CommandRunner
+	FS     FS
+	// Arch is the architecture of the host, Eg:- ppc64le
+	Arch string
+	// EUID is the effective user id of the process
+	EUID int
+}
+
+// Local returns the host the process is running on.
+func Local() *Host {
+	return &Host{
+		Runner: execRunner{},
+		FS:     osFS{},
+		Arch:   runtime.GOARCH,
+		EUID:   os.Geteuid(),
+	}
+}
+
+// execRunner runs the commands using os/exec.
+type execRunner struct{}
+
+func (execRunner) Run(ctx context.Context, name string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	// terminate the command first to let it clean up, Eg:- podman run --rm removing its container
+	cmd.Cancel = func() error {
+		return cmd.Process.Signal(syscall.SIGTERM)
+	}
+	cmd.WaitDelay = killDelay
+
+	return cmd.CombinedOutput()
+}
+
+func (execRunner) LookPath(file string) (string, error) {
+	return exec.LookPath(file)
+}
+
+// osFS reads the local filesystem.
+type osFS struct{}
+
+func (osFS) ReadFile(name string) ([]byte, error) {
+	return os.ReadFile(name)
+}
+
+func (osFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	return os.ReadDir(name)
+}
+
+func (osFS) Stat(name string) (fs.FileInfo, error) {
+	return os.Stat(name)
+}
+
+func (osFS) Statfs(path string, buf *syscall.Statfs_t) error {
+	return syscall.Statfs(path, buf)
+}
@@ -0,0 +1,194 @@
+// Package hosttest provides the recorded hosts to test the validations and the configurations against, off the target
+// hardware. It is only meant to be imported from the tests.
+package hosttest
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"testing/fstest"
+
+	"github.com/docker/go-units"
+	"go.yaml.in/yaml/v3"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+)
+
+const (
+	// fixtureSuffix is the suffix of the fixture files.
+	fixtureSuffix = ".yaml"
+	// fixtureBlockSize is the block size of the recorded filesystems.
+	fixtureBlockSize = 4096
+)
+
+// Fixture is a recorded host, used to verify the validations and the configurations off the target hardware.
+//
+// Eg:-
+//
+//	arch: ppc64le
+//	euid: 0
+//	files:
+//	  /etc/os-release: |
+//	    ID="rhel"
+//	    VERSION_ID="9.6"
+//	commands:
+//	  lscpu:
+//	    output: "NUMA node(s): 1"
+//	  dnf repolist:
+//	    output: "This system is not registered with an entitlement server."
+//	    exitCode: 1
+type Fixture struct {
+	Description string `yaml:"description,omitempty"`
+	Arch        string `yaml:"arch"`
+	EUID        int    `yaml:"euid"`
+	// Files holds the content of the files keyed by their absolute path
+	Files map[string]string `yaml:"files,omitempty"`
+	// Commands holds the result of the commands keyed by the command line, Eg:- "lspci -d 1014:06a7"
+	Commands map[string]CommandResult `yaml:"commands,omitempty"`
+	// DiskFree holds the free space of the filesystems keyed by their mount point, Eg:- "/": 500GiB
+	DiskFree map[string]string `yaml:"diskFree,omitempty"`
+}
+
+// CommandResult is the recorded result of a command.
+type CommandResult struct {
+	Output   string `yaml:"output,omitempty"`
+	ExitCode int    `yaml:"exitCode,omitempty"`
+}
+
+// ListFixtures returns the names of the fixtures recorded in dir, Eg:- the testdata directory of the host package.
+func ListFixtures(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fixtures: %w", err)
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if name, ok := strings.CutSuffix(entry.Name(), fixtureSuffix); ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// LoadFixture returns the named fixture recorded in dir, Eg:- power11-rhel96.
+func LoadFixture(dir, name string) (*Fixture, error) {
+	data, err := os.ReadFile(filepath.Join(dir, name+fixtureSuffix))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture %s: %w", name, err)
+	}
+
+	var fixture Fixture
+	if err := yaml.Unmarshal(data, &fixture); err != nil {
+		return nil, fmt.Errorf("failed to parse fixture %s: %w", name, err)
+	}
+
+	return &fixture, nil
+}
+
+// Host returns a host serving the recorded files and command results.
+func (f *Fixture) Host() (*host.Host, error) {
+	files := fstest.MapFS{}
+	for name, content := range f.Files {
+		files[relative(name)] = &fstest.MapFile{Data: []byte(content)}
+	}
+
+	diskFree := map[string]uint64{}
+	for mount, size := range f.DiskFree {
+		free, err := units.RAMInBytes(size)
+		if err != nil {
+			return nil, fmt.Errorf("invalid free disk space '%s' of %s: %w", size, mount, err)
+		}
+		//nolint:gosec // sizes are never negative
+		diskFree[path.Clean(mount)] = uint64(free)
+	}
+
+	return &host.Host{
+		Runner: fixtureRunner{commands: f.Commands},
+		FS:     fixtureFS{files: files, diskFree: diskFree},
+		Arch:   f.Arch,
+		EUID:   f.EUID,
+	}, nil
+}
+
+// fixtureRunner returns the recorded command results, commands which are not recorded are not found.
+type fixtureRunner struct {
+	commands map[string]CommandResult
+}
+
+func (r fixtureRunner) Run(ctx context.Context, name string, args ...string) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	result, ok := r.commands[strings.Join(append([]string{name}, args...), " ")]
+	if !ok {
+		return nil, &exec.Error{Name: name, Err: exec.ErrNotFound}
+	}
+
+	if result.ExitCode != 0 {
+		return []byte(result.Output), fmt.Errorf("exit status %d", result.ExitCode)
+	}
+
+	return []byte(result.Output), nil
+}
+
+func (r fixtureRunner) LookPath(file string) (string, error) {
+	for command := range r.commands {
+		if strings.Fields(command)[0] == file {
+			return path.Join("/usr/bin", file), nil
+		}
+	}
+
+	return "", &exec.Error{Name: file, Err: exec.ErrNotFound}
+}
+
+// fixtureFS serves the recorded files, the absolute paths are mapped onto the in-memory filesystem.
+type fixtureFS struct {
+	files    fstest.MapFS
+	diskFree map[string]uint64
+}
+
+func (f fixtureFS) ReadFile(name string) ([]byte, error) {
+	return f.files.ReadFile(relative(name))
+}
+
+func (f fixtureFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	return f.files.ReadDir(relative(name))
+}
+
+func (f fixtureFS) Stat(name string) (fs.FileInfo, error) {
+	return f.files.Stat(relative(name))
+}
+
+// Statfs reports the free space of the closest recorded mount point of the path.
+func (f fixtureFS) Statfs(name string, buf *syscall.Statfs_t) error {
+	for p := path.Clean(name); ; p = path.Dir(p) {
+		if free, ok := f.diskFree[p]; ok {
+			buf.Bsize = fixtureBlockSize
+			buf.Bavail = free / fixtureBlockSize
+
+			return nil
+		}
+		if p == "/" {
+			return &fs.PathError{Op: "statfs", Path: name, Err: fs.ErrNotExist}
+		}
+	}
+}
+
+func relative(name string) string {
+	name = strings.TrimPrefix(path.Clean(name), "/")
+	if name == "" {
+		return "."
+	}
+
+	return name
+}
@@ -1,6 +1,7 @@
 package image
 
 import (
+	"context"
 	"fmt"
 	"slices"
 
@@ -50,11 +51,11 @@ func ListImages(template, appName string) ([]string, error) {
 }
 
 // pullImageFromRegistry pulls the required images from registry.
-func pullImageFromRegistry(runtime runtime.Runtime, images []string) error {
+func pullImageFromRegistry(ctx context.Context, runtime runtime.Runtime, images []string) error {
 	for _, image := range images {
 		logger.Infoln("Downloading image: " + image + "...")
-		if err := utils.Retry(vars.RetryCount, vars.RetryInterval, nil, func() error {
-			return runtime.PullImage(image)
+		if err := utils.Retry(ctx, vars.RetryCount, vars.RetryInterval, nil, func() error {
+			return runtime.PullImage(ctx, image)
 		}); err != nil {
 			return fmt.Errorf("failed to download image: %w", err)
 		}
@@ -64,11 +65,11 @@ func pullImageFromRegistry(runtime runtime.Runtime, images []string) error {
 }
 
 // fetchImagesNotFound returns list of images which are not present locally.
-func fetchImagesNotFound(runtime runtime.Runtime, reqImages []string) ([]string, error) {
+func fetchImagesNotFound(ctx context.Context, runtime runtime.Runtime, reqImages []string) ([]string, error) {
 	notfoundImages := make([]string, 0, len(reqImages))
 
 	// Verify the images existing locally
-	lImages, err := runtime.ListImages()
+	lImages, err := runtime.ListImages(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list local images: %w", err)
 	}
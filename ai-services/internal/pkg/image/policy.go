@@ -1,6 +1,7 @@
 package image
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -40,21 +41,21 @@ func NewImagePull(runtime runtime.Runtime, policy ImagePullPolicy, app, appTempl
 }
 
 // Run runs a particular imagePullPolicy method type based on the policy set within the ImagePull object.
-func (p ImagePull) Run() error {
+func (p ImagePull) Run(ctx context.Context) error {
 	switch p.Policy {
 	case PullAlways:
-		return p.always()
+		return p.always(ctx)
 	case PullIfNotPresent:
-		return p.ifNotPresent()
+		return p.ifNotPresent(ctx)
 	case PullNever:
-		return p.never()
+		return p.never(ctx)
 	default:
 		return errors.New("unsupported policy set")
 	}
 }
 
 // always -> pulls all the images for a given app template.
-func (p ImagePull) always() error {
+func (p ImagePull) always(ctx context.Context) error {
 	// Fetch all images required for a given template
 	images, err := ListImages(p.AppTemplate, p.App)
 	if err != nil {
@@ -65,11 +66,11 @@ func (p ImagePull) always() error {
 	logger.Infoln("Downloading container images required for application template " + p.AppTemplate + ":")
 
 	// Pull all the images
-	return pullImageFromRegistry(p.Runtime, images)
+	return pullImageFromRegistry(ctx, p.Runtime, images)
 }
 
 // ifNotPresent -> pulls only the missing images for a given app template.
-func (p ImagePull) ifNotPresent() error {
+func (p ImagePull) ifNotPresent(ctx context.Context) error {
 	// Fetch all images required for a given template
 	images, err := ListImages(p.AppTemplate, p.App)
 	if err != nil {
@@ -77,18 +78,18 @@ func (p ImagePull) ifNotPresent() error {
 	}
 
 	// Fetch all the images which are not found locally
-	notFoundImages, err := fetchImagesNotFound(p.Runtime, images)
+	notFoundImages, err := fetchImagesNotFound(ctx, p.Runtime, images)
 	if err != nil {
 		return err
 	}
 
 	// Pull only those images which does not exist
-	return pullImageFromRegistry(p.Runtime, notFoundImages)
+	return pullImageFromRegistry(ctx, p.Runtime, notFoundImages)
 }
 
 // never -> never pulls any image.
 // It checks whether all the images for given appTemplate is present locally, if not then raises an error.
-func (p ImagePull) never() error {
+func (p ImagePull) never(ctx context.Context) error {
 	// Fetch all images required for a given template
 	images, err := ListImages(p.AppTemplate, p.App)
 	if err != nil {
@@ -96,7 +97,7 @@ func (p ImagePull) never() error {
 	}
 
 	// Fetch all the images which are not found locally
-	notFoundImages, err := fetchImagesNotFound(p.Runtime, images)
+	notFoundImages, err := fetchImagesNotFound(ctx, p.Runtime, images)
 	if err != nil {
 		return err
 	}
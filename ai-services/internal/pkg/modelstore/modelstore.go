@@ -0,0 +1,362 @@
+package modelstore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/hf"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+const (
+	// ManifestPath is the path of the manifest within the model directory, recording the files of the downloaded model.
+	ManifestPath = ".cache/ai-services/manifest.json"
+	// lastUsedPath is the path of the file within the model directory recording when an application last used it.
+	lastUsedPath = ".cache/ai-services/last-used"
+	// cacheDir is the directory within the model directory holding the download metadata, Eg:- of the hf cli.
+	cacheDir = ".cache"
+
+	dirPermissions  = 0o755
+	filePermissions = 0o644
+)
+
+// Manifest records the files of a downloaded model to verify its integrity later.
+type Manifest struct {
+	Model string `json:"model"`
+	// Revision is the revision requested, Eg:- main
+	Revision string `json:"revision"`
+	// Commit is the commit the revision resolved to when downloaded
+	Commit       string    `json:"commit"`
+	DownloadedAt time.Time `json:"downloadedAt"`
+	Files        []File    `json:"files"`
+}
+
+// File is a file of the downloaded model along with its checksum.
+type File struct {
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+	// SHA256 is the checksum of the files stored using LFS
+	SHA256 string `json:"sha256,omitempty"`
+	// BlobID is the git blob id of the other files
+	BlobID string `json:"blobId,omitempty"`
+}
+
+// hubFile returns the file as listed by the hub to compute its checksum.
+func (f File) hubFile() hf.File {
+	if f.SHA256 != "" {
+		return hf.File{Path: f.Path, Size: f.Size, LFS: &hf.LFS{SHA256: f.SHA256, Size: f.Size}}
+	}
+
+	return hf.File{Path: f.Path, Size: f.Size, BlobID: f.BlobID}
+}
+
+// Model is a model present in the model directory.
+type Model struct {
+	Name string `json:"name"`
+	Dir  string `json:"dir"`
+	Size uint64 `json:"size"`
+	// Revision is empty if the model was not downloaded by ai-services
+	Revision string `json:"revision,omitempty"`
+	// Commit is the commit of the model files, read from the download metadata if there is no manifest
+	Commit string `json:"commit,omitempty"`
+	// LastUsed is the last time an application was created using the model, nil if never recorded
+	LastUsed *time.Time `json:"lastUsed,omitempty"`
+}
+
+// WriteManifest records the files of the revision downloaded into the model directory.
+func WriteManifest(modelDir, model, revision string, info *hf.RepoInfo) error {
+	manifest := Manifest{Model: model, Revision: revision, Commit: info.SHA, DownloadedAt: time.Now().UTC(), Files: make([]File, 0, len(info.Files))}
+	for _, f := range info.Files {
+		file := File{Path: f.Path, Size: f.Size}
+		if f.LFS != nil {
+			file.SHA256 = f.LFS.SHA256
+		} else {
+			file.BlobID = f.BlobID
+		}
+		manifest.Files = append(manifest.Files, file)
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode model manifest: %w", err)
+	}
+
+	return writeFile(filepath.Join(modelDir, ManifestPath), data)
+}
+
+// ReadManifest returns the manifest recorded for the model directory.
+func ReadManifest(modelDir string) (*Manifest, error) {
+	data, err := os.ReadFile(filepath.Join(modelDir, ManifestPath))
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode model manifest: %w", err)
+	}
+
+	return manifest, nil
+}
+
+// MarkUsed records the model directory as used by an application now.
+func MarkUsed(modelDir string) error {
+	return writeFile(filepath.Join(modelDir, lastUsedPath), []byte(time.Now().UTC().Format(time.RFC3339)+"\n"))
+}
+
+// lastUsed returns when an application last used the model directory, nil if never recorded.
+func lastUsed(modelDir string) *time.Time {
+	data, err := os.ReadFile(filepath.Join(modelDir, lastUsedPath))
+	if err != nil {
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil
+	}
+
+	return &t
+}
+
+// writeFile writes the file atomically, creating its parent directories.
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), dirPermissions); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(path), err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, filePermissions); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// List returns the models present under the root directory sorted by their name. A model is a directory holding
+// files, its name is its path relative to the root, Eg:- ibm-granite/granite-3.3-8b-instruct.
+func List(root string) ([]Model, error) {
+	var models []Model
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == root && errors.Is(err, fs.ErrNotExist) {
+				return fs.SkipAll
+			}
+
+			return err
+		}
+		if !d.IsDir() || path == root {
+			return nil
+		}
+
+		isModel, err := isModelDir(path)
+		if err != nil || !isModel {
+			return err
+		}
+
+		name, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		models = append(models, inspect(filepath.ToSlash(name), path))
+
+		return fs.SkipDir
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the models under %s: %w", root, err)
+	}
+
+	slices.SortFunc(models, func(a, b Model) int { return strings.Compare(a.Name, b.Name) })
+
+	return models, nil
+}
+
+// isModelDir reports whether the directory holds a model, that is regular files or the download metadata, unlike the
+// directories of the organizations holding the models.
+func isModelDir(dir string) (bool, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false, err
+	}
+
+	for _, entry := range entries {
+		if entry.Type().IsRegular() || (entry.IsDir() && entry.Name() == cacheDir) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// inspect returns the details of the model in the directory.
+func inspect(name, dir string) Model {
+	model := Model{Name: name, Dir: dir, Size: utils.DirSize(dir), LastUsed: lastUsed(dir), Commit: Commit(dir)}
+	if manifest, err := ReadManifest(dir); err == nil {
+		model.Revision = manifest.Revision
+	}
+
+	return model
+}
+
+// Commit returns the commit of the model files in the directory, empty if unknown.
+func Commit(modelDir string) string {
+	if manifest, err := ReadManifest(modelDir); err == nil {
+		return manifest.Commit
+	}
+
+	// the models downloaded by the hf cli only record the commit of each file
+	commit := ""
+	_ = filepath.WalkDir(filepath.Join(modelDir, hf.MetadataDir), func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, hf.MetadataSuffix) {
+			return nil //nolint:nilerr // the models without the download metadata have no known commit
+		}
+		if c, _, err := hf.ReadMetadata(path); err == nil && c != "" {
+			commit = c
+
+			return fs.SkipAll
+		}
+
+		return nil
+	})
+
+	return commit
+}
+
+// Remove removes the model directory along with the parent directories left empty, up to the root directory.
+func Remove(root, name string) error {
+	if !filepath.IsLocal(filepath.FromSlash(name)) {
+		return fmt.Errorf("invalid model name '%s'", name)
+	}
+	dir := filepath.Join(root, filepath.FromSlash(name))
+
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("failed to remove model %s: %w", name, err)
+	}
+
+	for parent := filepath.Dir(dir); parent != filepath.Clean(root); parent = filepath.Dir(parent) {
+		// stops at the first parent not empty
+		if err := os.Remove(parent); err != nil {
+			break
+		}
+	}
+
+	return nil
+}
+
+// Problem is a file of the model failing the verification.
+type Problem struct {
+	Path string `json:"path"`
+	// Reason is why the file failed the verification, Eg:- missing
+	Reason string `json:"reason"`
+}
+
+// Verify verifies the files of the model directory against its manifest and returns the files failing the
+// verification. The progress is called with the path of each file being verified, if set.
+func Verify(modelDir string, progress func(path string)) ([]Problem, error) {
+	manifest, err := ReadManifest(modelDir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("no manifest is recorded for the model, download it again using `ai-services application model download` to record it")
+		}
+
+		return nil, err
+	}
+
+	problems := []Problem{}
+	for _, f := range manifest.Files {
+		if progress != nil {
+			progress(f.Path)
+		}
+		if reason := verifyFile(filepath.Join(modelDir, filepath.FromSlash(f.Path)), f); reason != "" {
+			problems = append(problems, Problem{Path: f.Path, Reason: reason})
+		}
+	}
+
+	return problems, nil
+}
+
+// verifyFile returns why the file fails the verification, empty if it is intact.
+func verifyFile(path string, f File) string {
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "missing"
+		}
+
+		return err.Error()
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return err.Error()
+	}
+	if stat.Size() != f.Size {
+		return fmt.Sprintf("size mismatch: expected %d bytes, got %d", f.Size, stat.Size())
+	}
+
+	hubFile := f.hubFile()
+	sum, err := hubFile.Checksum(file)
+	if err != nil {
+		return err.Error()
+	}
+	if expected := hubFile.ETag(); sum != expected {
+		return fmt.Sprintf("checksum mismatch: expected %s, got %s", expected, sum)
+	}
+
+	return ""
+}
+
+// Invalidate removes the download metadata of the files failing the verification, so that the next download fetches
+// them again instead of skipping them.
+func Invalidate(modelDir string, problems []Problem) error {
+	var errs []error
+	for _, problem := range problems {
+		metadata := filepath.Join(modelDir, hf.MetadataDir, filepath.FromSlash(problem.Path)+hf.MetadataSuffix)
+		if err := os.Remove(metadata); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// IsDownloaded reports whether the model directory holds the model, at the pinned commit if the reference is pinned.
+func IsDownloaded(modelDir string, ref hf.Ref) bool {
+	if entries, err := os.ReadDir(modelDir); err != nil || len(entries) == 0 {
+		return false
+	}
+
+	return !ref.IsPinned() || Commit(modelDir) == ref.Revision
+}
+
+// Deployed is a model an application is deployed with along with the commit on disk at the time.
+type Deployed struct {
+	Model string `json:"model"`
+	// Revision is the revision the template references, empty for the default revision
+	Revision string `json:"revision,omitempty"`
+	// Commit is the commit of the model files on disk, empty if unknown
+	Commit string `json:"commit,omitempty"`
+}
+
+// Deployment returns the models referenced along with the commit of their files under the root directory.
+func Deployment(root string, refs []hf.Ref) []Deployed {
+	deployed := make([]Deployed, 0, len(refs))
+	for _, ref := range refs {
+		deployed = append(deployed, Deployed{Model: ref.Repo, Revision: ref.Revision, Commit: Commit(filepath.Join(root, ref.Repo))})
+	}
+
+	return deployed
+}
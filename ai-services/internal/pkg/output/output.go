@@ -0,0 +1,249 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+// SchemaVersion is the version of the machine-readable documents emitted by the commands.
+// Bump it whenever a field is renamed or removed from any of the documents.
+const SchemaVersion = "ai-services.io/v1"
+
+// Supported output formats.
+const (
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+
+	customColumnsPrefix = "custom-columns="
+)
+
+// Options holds the output flags of a command.
+type Options struct {
+	// Output is the requested output format: json, yaml, custom-columns=<HEADER>:<.field>,...
+	// or one of the additional formats supported by the command (Eg:- wide).
+	Output string
+	// Template is the go-template applied to each item, set via --format.
+	Template string
+	// Writer is where the machine-readable output is written to. Defaults to stdout.
+	Writer io.Writer
+
+	extraFormats []string
+}
+
+// AddFlags registers the --output and --format flags on the command.
+// extraFormats are the human readable formats supported by the command on top of the common ones (Eg:- wide).
+func AddFlags(cmd *cobra.Command, opts *Options, extraFormats ...string) {
+	opts.extraFormats = extraFormats
+
+	formats := append(slices.Clone(extraFormats), FormatJSON, FormatYAML, customColumnsPrefix+"<HEADER>:<.field>,...")
+	cmd.Flags().StringVarP(&opts.Output, "output", "o", "", fmt.Sprintf("Output format (one of: %s)", strings.Join(formats, ", ")))
+	cmd.Flags().StringVar(&opts.Template, "format", "", "Go template applied to each item, fields are referred by their json names (Eg:- '{{.name}}')")
+}
+
+// Validate verifies the requested output format.
+func (o *Options) Validate() error {
+	if o.Output != "" && o.Template != "" {
+		return fmt.Errorf("--output and --format flags cannot be used together")
+	}
+
+	switch {
+	case o.Output == "", o.Output == FormatJSON, o.Output == FormatYAML, slices.Contains(o.extraFormats, o.Output):
+		return nil
+	case strings.HasPrefix(o.Output, customColumnsPrefix):
+		_, err := parseColumns(strings.TrimPrefix(o.Output, customColumnsPrefix))
+
+		return err
+	default:
+		return fmt.Errorf("unsupported output format '%s'", o.Output)
+	}
+}
+
+// Is reports whether the given format is requested.
+func (o *Options) Is(format string) bool {
+	return strings.EqualFold(o.Output, format)
+}
+
+// IsStructured reports whether the output is to be emitted through Print,
+// in which case the command should not print any free text on stdout.
+func (o *Options) IsStructured() bool {
+	return o.Template != "" || o.Output == FormatJSON || o.Output == FormatYAML || strings.HasPrefix(o.Output, customColumnsPrefix)
+}
+
+// Print emits the data in the requested structured format.
+// Data is either a slice of items, emitted under 'items', or a single object, emitted under 'data'.
+func (o *Options) Print(kind string, data any) error {
+	generic, err := toGeneric(data)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case o.Template != "":
+		return o.printTemplate(generic)
+	case strings.HasPrefix(o.Output, customColumnsPrefix):
+		return o.printColumns(generic)
+	}
+
+	doc := map[string]any{"apiVersion": SchemaVersion, "kind": kind}
+	if items, ok := generic.([]any); ok {
+		doc["items"] = items
+	} else {
+		doc["data"] = generic
+	}
+
+	var out []byte
+	if o.Output == FormatYAML {
+		out, err = yaml.Marshal(doc)
+	} else {
+		out, err = json.MarshalIndent(doc, "", "  ")
+		out = append(out, '\n')
+	}
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s output: %w", o.Output, err)
+	}
+
+	_, err = o.writer().Write(out)
+
+	return err
+}
+
+func (o *Options) writer() io.Writer {
+	if o.Writer != nil {
+		return o.Writer
+	}
+
+	return os.Stdout
+}
+
+// printTemplate executes the go-template against each item.
+func (o *Options) printTemplate(data any) error {
+	tmpl, err := template.New("format").Option("missingkey=zero").Parse(o.Template)
+	if err != nil {
+		return fmt.Errorf("failed to parse format template: %w", err)
+	}
+
+	for _, item := range asItems(data) {
+		if err := tmpl.Execute(o.writer(), item); err != nil {
+			return fmt.Errorf("failed to execute format template: %w", err)
+		}
+		if _, err := fmt.Fprintln(o.writer()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type column struct {
+	header string
+	path   []string
+}
+
+// parseColumns parses the custom columns spec. Eg:- NAME:.name,STATUS:.status.
+func parseColumns(spec string) ([]column, error) {
+	var columns []column
+	for c := range strings.SplitSeq(spec, ",") {
+		header, path, ok := strings.Cut(strings.TrimSpace(c), ":")
+		if !ok || header == "" || !strings.HasPrefix(path, ".") {
+			return nil, fmt.Errorf("invalid custom column '%s': must be of the form <HEADER>:<.field>", c)
+		}
+		columns = append(columns, column{header: header, path: strings.Split(strings.TrimPrefix(path, "."), ".")})
+	}
+
+	return columns, nil
+}
+
+// printColumns renders the requested fields of each item as plain columns, every value kept as is to be consumed by
+// the scripts.
+func (o *Options) printColumns(data any) error {
+	columns, err := parseColumns(strings.TrimPrefix(o.Output, customColumnsPrefix))
+	if err != nil {
+		return err
+	}
+
+	p := utils.NewPlainTableWriter()
+
+	headers := make([]string, 0, len(columns))
+	for _, c := range columns {
+		headers = append(headers, c.header)
+	}
+	p.SetHeaders(headers...)
+
+	for _, item := range asItems(data) {
+		row := make([]string, 0, len(columns))
+		for _, c := range columns {
+			row = append(row, lookup(item, c.path))
+		}
+		p.AppendRow(row...)
+	}
+	p.Render(o.writer())
+
+	return nil
+}
+
+// lookup returns the value of the field path within the item, or '<none>' if not present.
+func lookup(item any, path []string) string {
+	for _, field := range path {
+		m, ok := item.(map[string]any)
+		if !ok {
+			return "<none>"
+		}
+		if item, ok = m[field]; !ok {
+			return "<none>"
+		}
+	}
+
+	switch v := item.(type) {
+	case nil:
+		return "<none>"
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, e := range v {
+			values = append(values, fmt.Sprint(e))
+		}
+
+		return strings.Join(values, ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// toGeneric converts the data to its json representation (maps, slices and scalars)
+// so that the templates and the custom columns refer to the same field names as the json output.
+func toGeneric(data any) (any, error) {
+	// always emit an empty list rather than null for the list documents
+	if v := reflect.ValueOf(data); v.Kind() == reflect.Slice && v.Len() == 0 {
+		return []any{}, nil
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal output: %w", err)
+	}
+
+	var generic any
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal output: %w", err)
+	}
+
+	return generic, nil
+}
+
+func asItems(data any) []any {
+	if items, ok := data.([]any); ok {
+		return items
+	}
+
+	return []any{data}
+}
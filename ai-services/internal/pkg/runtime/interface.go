@@ -1,6 +1,7 @@
 package runtime
 
 import (
+	"context"
 	"io"
 
 	"github.com/containers/podman/v5/libpod/define"
@@ -8,18 +9,22 @@ import (
 )
 
 type Runtime interface {
-	ListImages() ([]Image, error)
-	PullImage(image string) error
-	ListPods(filters map[string][]string) ([]Pod, error)
-	CreatePod(body io.Reader) ([]Pod, error)
-	DeletePod(id string, force *bool) error
-	StopPod(id string) error
-	StartPod(id string) error
-	InspectContainer(nameOrId string) (*define.InspectContainerData, error)
-	ListContainers(filters map[string][]string) ([]Container, error)
-	InspectPod(nameOrId string) (*types.PodInspectReport, error)
-	PodExists(nameOrID string) (bool, error)
-	PodLogs(nameOrID string) error
-	ContainerLogs(containerNameOrID string) error
-	ContainerExists(nameOrID string) (bool, error)
+	ListImages(ctx context.Context) ([]Image, error)
+	PullImage(ctx context.Context, image string) error
+	ListPods(ctx context.Context, filters map[string][]string) ([]Pod, error)
+	CreatePod(ctx context.Context, body io.Reader, opts map[string]string) (*types.KubePlayReport, error)
+	DeletePod(ctx context.Context, id string, force *bool) error
+	StopPod(ctx context.Context, id string) error
+	StartPod(ctx context.Context, id string) error
+	InspectContainer(ctx context.Context, nameOrId string) (*define.InspectContainerData, error)
+	ListContainers(ctx context.Context, filters map[string][]string) ([]Container, error)
+	InspectPod(ctx context.Context, nameOrId string) (*types.PodInspectReport, error)
+	PodExists(ctx context.Context, nameOrID string) (bool, error)
+	PodLogs(ctx context.Context, nameOrID string) error
+	ContainerLogs(ctx context.Context, containerNameOrID string) error
+	// FetchContainerLogs writes the logs of the container so far to the writer, without following them.
+	FetchContainerLogs(ctx context.Context, containerNameOrID string, w io.Writer) error
+	ContainerExists(ctx context.Context, nameOrID string) (bool, error)
+	Exec(ctx context.Context, containerNameOrID string, opts ExecOptions) (int, error)
+	Stats(ctx context.Context, containerIDs []string, stream bool) (<-chan ContainerStatsReport, error)
 }
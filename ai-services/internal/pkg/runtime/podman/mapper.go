@@ -3,6 +3,7 @@ package podman
 import (
 	"strings"
 
+	"github.com/containers/podman/v5/libpod/define"
 	"github.com/containers/podman/v5/pkg/domain/entities/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
 )
@@ -24,16 +25,6 @@ func toPodsList(input any) []runtime.Pod {
 
 		return out
 
-	case *types.KubePlayReport:
-		out := make([]runtime.Pod, 0, len(val.Pods))
-		for _, r := range val.Pods {
-			out = append(out, runtime.Pod{
-				ID: r.ID,
-			})
-		}
-
-		return out
-
 	default:
 		panic("unsupported type to do mapper to podList")
 	}
@@ -79,3 +70,28 @@ func toImageList(input []*types.ImageSummary) []runtime.Image {
 
 	return out
 }
+
+// toContainerStatsList - convert podman container stats to desired type.
+func toContainerStatsList(input []define.ContainerStats) []runtime.ContainerStats {
+	out := make([]runtime.ContainerStats, 0, len(input))
+	for _, r := range input {
+		stats := runtime.ContainerStats{
+			ID:          r.ContainerID,
+			Name:        r.Name,
+			CPUPercent:  r.CPU,
+			MemUsage:    r.MemUsage,
+			MemLimit:    r.MemLimit,
+			MemPercent:  r.MemPerc,
+			BlockInput:  r.BlockInput,
+			BlockOutput: r.BlockOutput,
+			PIDs:        r.PIDs,
+		}
+		for _, net := range r.Network {
+			stats.NetInput += net.RxBytes
+			stats.NetOutput += net.TxBytes
+		}
+		out = append(out, stats)
+	}
+
+	return out
+}
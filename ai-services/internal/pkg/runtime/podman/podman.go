@@ -1,6 +1,7 @@
 package podman
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	"fmt"
@@ -8,48 +9,84 @@ import (
 	"os"
 	"os/exec"
 	"os/signal"
+	"strings"
 	"syscall"
 
 	"github.com/containers/podman/v5/libpod/define"
+	"github.com/containers/podman/v5/pkg/api/handlers"
 	"github.com/containers/podman/v5/pkg/bindings"
 	"github.com/containers/podman/v5/pkg/bindings/containers"
 	"github.com/containers/podman/v5/pkg/bindings/images"
 	"github.com/containers/podman/v5/pkg/bindings/kube"
 	"github.com/containers/podman/v5/pkg/bindings/pods"
 	"github.com/containers/podman/v5/pkg/domain/entities/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/connection"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+	"golang.org/x/term"
 )
 
 type PodmanClient struct {
+	// Context carries the podman connection, per call contexts are derived from it via withConnection.
 	Context context.Context
+	// Host is the name of the connection the client is connected to (empty for unnamed connections).
+	Host string
 }
 
 // NewPodmanClient creates and returns a new PodmanClient instance.
 func NewPodmanClient() (*PodmanClient, error) {
 	// Default Podman socket URI is unix:///run/podman/podman.sock running on the local machine,
-	// but it can be overridden by the CONTAINER_HOST and CONTAINER_SSHKEY environment variable to support remote connections.
-	// Please use `podman system connection list` to see available connections.
+	// but it can be overridden by the --connection flag, the CONTAINER_HOST and CONTAINER_SSHKEY environment variables
+	// or the default connection set via `ai-services connection use` to support remote connections.
+	// Please use `ai-services connection list` to see available connections.
 	// Reference:
 	// MacOS instructions running in a remote VM:
 	// export CONTAINER_HOST=ssh://root@127.0.0.1:62904/run/podman/podman.sock
 	// export CONTAINER_SSHKEY=/Users/manjunath/.local/share/containers/podman/machine/machine
-	uri := "unix:///run/podman/podman.sock"
-	if v, found := os.LookupEnv("CONTAINER_HOST"); found {
-		uri = v
+	conn, err := connection.Resolve(vars.Connection)
+	if err != nil {
+		return nil, err
 	}
-	ctx, err := bindings.NewConnection(context.Background(), uri)
+
+	return NewPodmanClientForConnection(conn)
+}
+
+// NewPodmanClientForConnection creates and returns a new PodmanClient instance connected to the given connection.
+func NewPodmanClientForConnection(conn connection.Connection) (*PodmanClient, error) {
+	ctx, err := bindings.NewConnectionWithIdentity(context.Background(), conn.URI, conn.Identity, false)
 	if err != nil {
 		return nil, err
 	}
 
-	return &PodmanClient{Context: ctx}, nil
+	return &PodmanClient{Context: ctx, Host: conn.Name}, nil
+}
+
+// connectionContext carries the podman connection of the client while honoring
+// the deadline and cancellation of the caller's context.
+type connectionContext struct {
+	context.Context
+	conn context.Context
+}
+
+func (c connectionContext) Value(key any) any {
+	if v := c.Context.Value(key); v != nil {
+		return v
+	}
+
+	return c.conn.Value(key)
+}
+
+// withConnection - returns a context derived from ctx which carries the podman connection.
+func (pc *PodmanClient) withConnection(ctx context.Context) context.Context {
+	return connectionContext{Context: ctx, conn: pc.Context}
 }
 
 // ListImages function to list images (you can expand with more Podman functionalities).
-func (pc *PodmanClient) ListImages() ([]runtime.Image, error) {
-	images, err := images.List(pc.Context, nil)
+func (pc *PodmanClient) ListImages(ctx context.Context) ([]runtime.Image, error) {
+	images, err := images.List(pc.withConnection(ctx), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -57,9 +94,9 @@ func (pc *PodmanClient) ListImages() ([]runtime.Image, error) {
 	return toImageList(images), nil
 }
 
-func (pc *PodmanClient) PullImage(image string) error {
+func (pc *PodmanClient) PullImage(ctx context.Context, image string) error {
 	logger.Infof("Pulling image %s...\n", image)
-	_, err := images.Pull(pc.Context, image, nil)
+	_, err := images.Pull(pc.withConnection(ctx), image, nil)
 	if err != nil {
 		return fmt.Errorf("failed to pull image %s: %w", image, err)
 	}
@@ -68,14 +105,14 @@ func (pc *PodmanClient) PullImage(image string) error {
 	return nil
 }
 
-func (pc *PodmanClient) ListPods(filters map[string][]string) ([]runtime.Pod, error) {
+func (pc *PodmanClient) ListPods(ctx context.Context, filters map[string][]string) ([]runtime.Pod, error) {
 	var listOpts pods.ListOptions
 
 	if len(filters) >= 1 {
 		listOpts.Filters = filters
 	}
 
-	podList, err := pods.List(pc.Context, &listOpts)
+	podList, err := pods.List(pc.withConnection(ctx), &listOpts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list pods: %w", err)
 	}
@@ -83,17 +120,41 @@ func (pc *PodmanClient) ListPods(filters map[string][]string) ([]runtime.Pod, er
 	return toPodsList(podList), nil
 }
 
-func (pc *PodmanClient) CreatePod(body io.Reader) ([]runtime.Pod, error) {
-	kubeReport, err := kube.PlayWithBody(pc.Context, body, nil)
+// CreatePod deploys the kube spec read from body and returns the structured kube play report.
+// Supported opts are 'start' (on/off) and 'publish' (comma separated 'hostPort:containerPort' values).
+func (pc *PodmanClient) CreatePod(ctx context.Context, body io.Reader, opts map[string]string) (*types.KubePlayReport, error) {
+	kubeReport, err := kube.PlayWithBody(pc.withConnection(ctx), body, buildPlayOptions(opts))
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute podman kube play: %w", err)
 	}
 
-	return toPodsList(kubeReport), nil
+	return kubeReport, nil
 }
 
-func (pc *PodmanClient) DeletePod(id string, force *bool) error {
-	_, err := pods.Remove(pc.Context, id, &pods.RemoveOptions{Force: force})
+// buildPlayOptions - converts the pod deploy options to kube play options.
+func buildPlayOptions(opts map[string]string) *kube.PlayOptions {
+	playOpts := new(kube.PlayOptions)
+
+	if v, ok := opts["start"]; ok {
+		// by default go with start set to true
+		playOpts.WithStart(v != constants.PodStartOff)
+	}
+
+	if v, ok := opts["publish"]; ok {
+		publishPorts := []string{}
+		for portMapping := range strings.SplitSeq(v, ",") {
+			if portMapping != "" {
+				publishPorts = append(publishPorts, portMapping)
+			}
+		}
+		playOpts.WithPublishPorts(publishPorts)
+	}
+
+	return playOpts
+}
+
+func (pc *PodmanClient) DeletePod(ctx context.Context, id string, force *bool) error {
+	_, err := pods.Remove(pc.withConnection(ctx), id, &pods.RemoveOptions{Force: force})
 	if err != nil {
 		return fmt.Errorf("failed to delete the pod: %w", err)
 	}
@@ -101,8 +162,8 @@ func (pc *PodmanClient) DeletePod(id string, force *bool) error {
 	return nil
 }
 
-func (pc *PodmanClient) InspectContainer(nameOrId string) (*define.InspectContainerData, error) {
-	stats, err := containers.Inspect(pc.Context, nameOrId, nil)
+func (pc *PodmanClient) InspectContainer(ctx context.Context, nameOrId string) (*define.InspectContainerData, error) {
+	stats, err := containers.Inspect(pc.withConnection(ctx), nameOrId, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to inspect container: %w", err)
 	}
@@ -114,14 +175,14 @@ func (pc *PodmanClient) InspectContainer(nameOrId string) (*define.InspectContai
 	return stats, nil
 }
 
-func (pc *PodmanClient) ListContainers(filters map[string][]string) ([]runtime.Container, error) {
+func (pc *PodmanClient) ListContainers(ctx context.Context, filters map[string][]string) ([]runtime.Container, error) {
 	var listOpts containers.ListOptions
 
 	if len(filters) >= 1 {
 		listOpts.Filters = filters
 	}
 
-	containerlist, err := containers.List(pc.Context, &listOpts)
+	containerlist, err := containers.List(pc.withConnection(ctx), &listOpts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list containers: %w", err)
 	}
@@ -129,8 +190,8 @@ func (pc *PodmanClient) ListContainers(filters map[string][]string) ([]runtime.C
 	return toContainerList(containerlist), nil
 }
 
-func (pc *PodmanClient) StopPod(id string) error {
-	inspectReport, err := pc.InspectPod(id)
+func (pc *PodmanClient) StopPod(ctx context.Context, id string) error {
+	inspectReport, err := pc.InspectPod(ctx, id)
 	if err != nil {
 		return fmt.Errorf("failed to inspect pod: %w", err)
 	}
@@ -138,13 +199,13 @@ func (pc *PodmanClient) StopPod(id string) error {
 	for _, container := range inspectReport.Containers {
 		// skipping infra container as it will be stopped when other containers are stopped
 		if container.ID != inspectReport.InfraContainerID {
-			err := containers.Stop(pc.Context, container.ID, nil)
+			err := containers.Stop(pc.withConnection(ctx), container.ID, nil)
 			if err != nil {
 				return fmt.Errorf("failed to stop pod container %s; err: %w", container.ID, err)
 			}
 		}
 	}
-	_, err = pods.Stop(pc.Context, id, &pods.StopOptions{})
+	_, err = pods.Stop(pc.withConnection(ctx), id, &pods.StopOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to stop the pod: %w", err)
 	}
@@ -152,10 +213,10 @@ func (pc *PodmanClient) StopPod(id string) error {
 	return nil
 }
 
-func (pc *PodmanClient) StartPod(id string) error {
+func (pc *PodmanClient) StartPod(ctx context.Context, id string) error {
 	//nolint:godox
 	// TODO: perform pod start SDK way
-	cmdExec := exec.Command("podman", "pod", "start", id)
+	cmdExec := exec.CommandContext(ctx, "podman", "pod", "start", id)
 	cmdExec.Stdout = os.Stdout
 	cmdExec.Stderr = os.Stderr
 
@@ -167,8 +228,8 @@ func (pc *PodmanClient) StartPod(id string) error {
 	return nil
 }
 
-func (pc *PodmanClient) InspectPod(nameOrID string) (*types.PodInspectReport, error) {
-	podInspectReport, err := pods.Inspect(pc.Context, nameOrID, nil)
+func (pc *PodmanClient) InspectPod(ctx context.Context, nameOrID string) (*types.PodInspectReport, error) {
+	podInspectReport, err := pods.Inspect(pc.withConnection(ctx), nameOrID, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to inspect the pod: %w", err)
 	}
@@ -176,17 +237,14 @@ func (pc *PodmanClient) InspectPod(nameOrID string) (*types.PodInspectReport, er
 	return podInspectReport, nil
 }
 
-func (pc *PodmanClient) PodLogs(podNameOrID string) error {
+func (pc *PodmanClient) PodLogs(ctx context.Context, podNameOrID string) error {
 	if podNameOrID == "" {
 		return errors.New("pod name or ID cannot be empty")
 	}
 
-	ctx, cancel := context.WithCancel(pc.Context)
-	defer cancel()
-
 	//nolint:godox
 	// TODO: fetch pods logs via sdk way
-	cmdExec := exec.CommandContext(pc.Context, "podman", "pod", "logs", "-f", podNameOrID)
+	cmdExec := exec.CommandContext(ctx, "podman", "pod", "logs", "-f", podNameOrID)
 	cmdExec.Stdout = os.Stdout
 	cmdExec.Stderr = os.Stderr
 
@@ -200,17 +258,17 @@ func (pc *PodmanClient) PodLogs(podNameOrID string) error {
 	return err
 }
 
-func (pc *PodmanClient) PodExists(nameOrID string) (bool, error) {
-	return pods.Exists(pc.Context, nameOrID, nil)
+func (pc *PodmanClient) PodExists(ctx context.Context, nameOrID string) (bool, error) {
+	return pods.Exists(pc.withConnection(ctx), nameOrID, nil)
 }
 
-func (pc *PodmanClient) ContainerLogs(containerNameOrID string) error {
+func (pc *PodmanClient) ContainerLogs(ctx context.Context, containerNameOrID string) error {
 	if containerNameOrID == "" {
 		return fmt.Errorf("container name or ID required to fetch logs")
 	}
 
 	// Creating context here that listens for Ctrl+C
-	ctx, stop := signal.NotifyContext(pc.Context, os.Interrupt, syscall.SIGTERM)
+	ctx, stop := signal.NotifyContext(pc.withConnection(ctx), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
 	stdoutChan := make(chan string)
@@ -254,6 +312,169 @@ func (pc *PodmanClient) ContainerLogs(containerNameOrID string) error {
 	return err
 }
 
-func (pc *PodmanClient) ContainerExists(nameOrID string) (bool, error) {
-	return containers.Exists(pc.Context, nameOrID, nil)
+// FetchContainerLogs writes the stdout and the stderr logs of the container so far to the writer, without following them.
+func (pc *PodmanClient) FetchContainerLogs(ctx context.Context, containerNameOrID string, w io.Writer) error {
+	if containerNameOrID == "" {
+		return fmt.Errorf("container name or ID required to fetch logs")
+	}
+
+	stdoutChan := make(chan string)
+	stderrChan := make(chan string)
+
+	opts := &containers.LogOptions{
+		Follow: utils.BoolPtr(false),
+		Stderr: utils.BoolPtr(true),
+		Stdout: utils.BoolPtr(true),
+	}
+
+	// the frames of both the streams are written in the order they are received, they hold their line endings
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		stdout, stderr := stdoutChan, stderrChan
+		for stdout != nil || stderr != nil {
+			select {
+			case frame, ok := <-stdout:
+				if !ok {
+					stdout = nil
+
+					continue
+				}
+				_, _ = io.WriteString(w, frame)
+			case frame, ok := <-stderr:
+				if !ok {
+					stderr = nil
+
+					continue
+				}
+				_, _ = io.WriteString(w, frame)
+			}
+		}
+	}()
+
+	// the channels are not closed by the bindings once the logs are read
+	err := containers.Logs(pc.withConnection(ctx), containerNameOrID, opts, stdoutChan, stderrChan)
+	close(stdoutChan)
+	close(stderrChan)
+	<-done
+
+	return err
+}
+
+func (pc *PodmanClient) ContainerExists(ctx context.Context, nameOrID string) (bool, error) {
+	return containers.Exists(pc.withConnection(ctx), nameOrID, nil)
+}
+
+// Exec runs the command inside the given container, attaching it to the current terminal streams.
+// Returns the exit code of the command.
+func (pc *PodmanClient) Exec(ctx context.Context, containerNameOrID string, opts runtime.ExecOptions) (int, error) {
+	connCtx := pc.withConnection(ctx)
+
+	execConfig := new(handlers.ExecCreateConfig)
+	execConfig.Cmd = opts.Cmd
+	execConfig.Tty = opts.Tty
+	execConfig.AttachStdin = opts.Interactive
+	execConfig.AttachStdout = true
+	execConfig.AttachStderr = true
+
+	// the local terminal is used as the pseudo-TTY of the command only if stdin is a terminal
+	stdin := int(os.Stdin.Fd())
+	tty := opts.Tty && term.IsTerminal(stdin)
+	if tty {
+		if width, height, err := term.GetSize(stdin); err == nil {
+			execConfig.ConsoleSize = &[2]uint{uint(height), uint(width)}
+		}
+	}
+
+	sessionID, err := containers.ExecCreate(connCtx, containerNameOrID, execConfig)
+	if err != nil {
+		return -1, fmt.Errorf("failed to create exec session: %w", err)
+	}
+
+	if tty {
+		state, err := term.MakeRaw(stdin)
+		if err != nil {
+			return -1, fmt.Errorf("failed to set the terminal to raw mode: %w", err)
+		}
+		defer func() {
+			if err := term.Restore(stdin, state); err != nil {
+				logger.Warningf("failed to restore the terminal: %v\n", err)
+			}
+		}()
+
+		resizeCtx, cancel := context.WithCancel(connCtx)
+		defer cancel()
+		go forwardResize(resizeCtx, sessionID, stdin)
+	}
+
+	attachOpts := new(containers.ExecStartAndAttachOptions).
+		WithOutputStream(os.Stdout).
+		WithErrorStream(os.Stderr).
+		WithAttachOutput(true).
+		WithAttachError(true)
+	if opts.Interactive {
+		attachOpts.WithInputStream(*bufio.NewReader(os.Stdin)).WithAttachInput(true)
+	}
+
+	if err := containers.ExecStartAndAttach(connCtx, sessionID, attachOpts); err != nil {
+		return -1, fmt.Errorf("failed to start exec session: %w", err)
+	}
+
+	session, err := containers.ExecInspect(connCtx, sessionID, nil)
+	if err != nil {
+		return -1, fmt.Errorf("failed to inspect exec session: %w", err)
+	}
+
+	return session.ExitCode, nil
+}
+
+// forwardResize resizes the pseudo-TTY of the exec session whenever the local terminal is resized, until the
+// context is cancelled.
+func forwardResize(ctx context.Context, sessionID string, fd int) {
+	winch := make(chan os.Signal, 1)
+	signal.Notify(winch, syscall.SIGWINCH)
+	defer signal.Stop(winch)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-winch:
+			width, height, err := term.GetSize(fd)
+			if err != nil {
+				continue
+			}
+			opts := new(containers.ResizeExecTTYOptions).WithHeight(height).WithWidth(width)
+			if err := containers.ResizeExecTTY(ctx, sessionID, opts); err != nil {
+				logger.Infof("failed to resize the exec session TTY: %v\n", err, logger.VerbosityLevelDebug)
+			}
+		}
+	}
+}
+
+// Stats streams the resource usage of the given containers until the context is cancelled.
+// When stream is false, only a single report is sent. The returned channel is closed once done.
+func (pc *PodmanClient) Stats(ctx context.Context, containerIDs []string, stream bool) (<-chan runtime.ContainerStatsReport, error) {
+	statsChan, err := containers.Stats(pc.withConnection(ctx), containerIDs, new(containers.StatsOptions).WithStream(stream))
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan runtime.ContainerStatsReport)
+	go func() {
+		defer close(out)
+		for report := range statsChan {
+			select {
+			case out <- runtime.ContainerStatsReport{Stats: toContainerStatsList(report.Stats), Error: report.Error}:
+			case <-ctx.Done():
+				// drain the podman channel so that its goroutine can exit
+				for range statsChan {
+				}
+
+				return
+			}
+		}
+	}()
+
+	return out, nil
 }
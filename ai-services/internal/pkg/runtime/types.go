@@ -18,3 +18,34 @@ type Image struct {
 	RepoTags    []string
 	RepoDigests []string
 }
+
+// ExecOptions holds the options to run a command inside a container.
+type ExecOptions struct {
+	Cmd []string
+	// Interactive keeps STDIN attached to the command
+	Interactive bool
+	// Tty allocates a pseudo-TTY for the command
+	Tty bool
+}
+
+// ContainerStats holds the resource usage of a container.
+type ContainerStats struct {
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	CPUPercent float64 `json:"cpuPercent"`
+	MemUsage   uint64  `json:"memUsage"`
+	MemLimit   uint64  `json:"memLimit"`
+	MemPercent float64 `json:"memPercent"`
+	// NetInput and NetOutput are the bytes received and sent summed across all the network interfaces
+	NetInput    uint64 `json:"netInput"`
+	NetOutput   uint64 `json:"netOutput"`
+	BlockInput  uint64 `json:"blockInput"`
+	BlockOutput uint64 `json:"blockOutput"`
+	PIDs        uint64 `json:"pids"`
+}
+
+// ContainerStatsReport holds the stats of the requested containers at a given interval.
+type ContainerStatsReport struct {
+	Stats []ContainerStats
+	Error error
+}
@@ -0,0 +1,345 @@
+package smt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	smtrule "github.com/project-ai-services/ai-services/internal/pkg/validators/smt"
+)
+
+const (
+	// MinLevel and MaxLevel are the SMT levels supported by the Power processors.
+	MinLevel = 1
+	MaxLevel = 8
+
+	// UnitName is the systemd unit setting the SMT level on boot when the level is persisted.
+	UnitName = "ai-services-smt.service"
+	// UnitPath is the path the systemd unit is installed at.
+	UnitPath = "/etc/systemd/system/" + UnitName
+
+	dirPermissions  = 0o755
+	filePermissions = 0o644
+
+	unitTemplate = `[Unit]
+Description=Set the SMT level required by the AI Services applications
+After=multi-user.target
+
+[Service]
+Type=oneshot
+ExecStart=%s --smt=%d
+
+[Install]
+WantedBy=multi-user.target
+`
+)
+
+// State is the SMT level managed by ai-services, stored across the commands.
+type State struct {
+	// Original is the SMT level before ai-services changed it, 0 if the level is not changed
+	Original int `json:"original,omitempty"`
+	// Level is the SMT level set by ai-services
+	Level int `json:"level,omitempty"`
+	// Applications holds the SMT level required by each deployed application
+	Applications map[string]int `json:"applications,omitempty"`
+	// Persist sets the level on boot through a systemd unit
+	Persist bool `json:"persist,omitempty"`
+}
+
+// ConflictError is returned when the SMT level conflicts with the level required by a deployed application.
+type ConflictError struct {
+	App   string
+	Level int
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("application '%s' requires SMT level %d", e.App, e.Level)
+}
+
+// Manager changes the SMT level of the host, recording the original level to restore it once no application
+// requires a level anymore.
+type Manager struct {
+	host      *host.Host
+	statePath string
+	unitPath  string
+}
+
+// NewManager returns the SMT manager of the host.
+func NewManager(h *host.Host) *Manager {
+	return NewManagerWithPaths(h, constants.SMTStatePath, UnitPath)
+}
+
+// NewManagerWithPaths returns the SMT manager of the host storing its state and installing the systemd unit at the
+// given paths.
+func NewManagerWithPaths(h *host.Host, statePath, unitPath string) *Manager {
+	return &Manager{host: h, statePath: statePath, unitPath: unitPath}
+}
+
+// Current returns the current SMT level of the host.
+func (m *Manager) Current(ctx context.Context) (int, error) {
+	return smtrule.CurrentLevel(ctx, m.host.Runner)
+}
+
+// State returns the stored SMT state. Returns an empty state if none is stored.
+func (m *Manager) State() (*State, error) {
+	state := &State{Applications: map[string]int{}}
+	data, err := os.ReadFile(m.statePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return state, nil
+		}
+
+		return nil, fmt.Errorf("failed to read SMT state: %w", err)
+	}
+
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to parse SMT state %s: %w", m.statePath, err)
+	}
+	if state.Applications == nil {
+		state.Applications = map[string]int{}
+	}
+
+	return state, nil
+}
+
+// Acquire sets the SMT level required by the application and records the application as its user.
+// Returns a ConflictError if another deployed application requires a different level.
+func (m *Manager) Acquire(ctx context.Context, app string, level int) error {
+	if err := ValidateLevel(level); err != nil {
+		return err
+	}
+
+	state, err := m.State()
+	if err != nil {
+		return err
+	}
+
+	if err := conflict(state, level, app); err != nil {
+		return err
+	}
+
+	if err := m.apply(ctx, state, level); err != nil {
+		return err
+	}
+	state.Applications[app] = level
+
+	return m.save(state)
+}
+
+// Release removes the application from the users of the SMT level. The original level is restored once no
+// application requires a level anymore.
+func (m *Manager) Release(ctx context.Context, app string) error {
+	state, err := m.State()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := state.Applications[app]; !ok {
+		return nil
+	}
+	delete(state.Applications, app)
+
+	if len(state.Applications) > 0 {
+		return m.save(state)
+	}
+
+	logger.Infof("No application requires an SMT level anymore, restoring the original level %d\n", state.Original)
+
+	return m.restore(ctx, state)
+}
+
+// Set sets the SMT level of the host, persisting it across reboots if persist is set.
+// Returns a ConflictError if a deployed application requires a different level.
+func (m *Manager) Set(ctx context.Context, level int, persist bool) error {
+	if err := ValidateLevel(level); err != nil {
+		return err
+	}
+
+	state, err := m.State()
+	if err != nil {
+		return err
+	}
+
+	if err := conflict(state, level, ""); err != nil {
+		return err
+	}
+
+	state.Persist = state.Persist || persist
+	if err := m.apply(ctx, state, level); err != nil {
+		return err
+	}
+
+	return m.save(state)
+}
+
+// Restore restores the original SMT level of the host and removes the persisted level.
+// Refuses while applications require a level, unless force is set.
+func (m *Manager) Restore(ctx context.Context, force bool) error {
+	state, err := m.State()
+	if err != nil {
+		return err
+	}
+
+	if len(state.Applications) > 0 && !force {
+		apps := slices.Sorted(maps.Keys(state.Applications))
+
+		return fmt.Errorf("SMT level is required by the applications: %s; delete them first or use --force", strings.Join(apps, ", "))
+	}
+
+	return m.restore(ctx, state)
+}
+
+// restore sets the original level back and clears the state.
+func (m *Manager) restore(ctx context.Context, state *State) error {
+	if state.Original != 0 {
+		if err := m.setLevel(ctx, state.Original); err != nil {
+			return err
+		}
+	}
+
+	if state.Persist {
+		if err := m.removeUnit(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := os.Remove(m.statePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove SMT state: %w", err)
+	}
+
+	return nil
+}
+
+// apply sets the level on the host, recording the original level on the first change.
+func (m *Manager) apply(ctx context.Context, state *State, level int) error {
+	current, err := m.Current(ctx)
+	if err != nil {
+		return err
+	}
+
+	if state.Original == 0 {
+		state.Original = current
+	}
+	state.Level = level
+
+	if current != level {
+		if err := m.setLevel(ctx, level); err != nil {
+			return err
+		}
+	}
+
+	if state.Persist {
+		return m.writeUnit(ctx, level)
+	}
+
+	return nil
+}
+
+// setLevel sets the SMT level on the host and verifies it.
+func (m *Manager) setLevel(ctx context.Context, level int) error {
+	current, err := m.Current(ctx)
+	if err == nil && current == level {
+		return nil
+	}
+
+	logger.Infof("Setting SMT level to %d\n", level)
+	if out, err := m.host.Runner.Run(ctx, "ppc64_cpu", "--smt="+strconv.Itoa(level)); err != nil {
+		return fmt.Errorf("failed to set SMT level: %v, output: %s", err, string(out))
+	}
+
+	if err := smtrule.NewSMTRule(m.host, level).Verify(ctx); err != nil {
+		return fmt.Errorf("SMT level verification failed: %w", err)
+	}
+
+	return nil
+}
+
+func (m *Manager) save(state *State) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode SMT state: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(m.statePath), dirPermissions); err != nil {
+		return fmt.Errorf("failed to create SMT state directory: %w", err)
+	}
+
+	if err := os.WriteFile(m.statePath, data, filePermissions); err != nil {
+		return fmt.Errorf("failed to write SMT state: %w", err)
+	}
+
+	return nil
+}
+
+// writeUnit installs and enables the systemd unit setting the level on boot.
+func (m *Manager) writeUnit(ctx context.Context, level int) error {
+	ppc64CPU, err := m.host.Runner.LookPath("ppc64_cpu")
+	if err != nil {
+		return fmt.Errorf("ppc64_cpu not found: %w", err)
+	}
+
+	if err := os.WriteFile(m.unitPath, fmt.Appendf(nil, unitTemplate, ppc64CPU, level), filePermissions); err != nil {
+		return fmt.Errorf("failed to write %s: %w", m.unitPath, err)
+	}
+
+	if out, err := m.host.Runner.Run(ctx, "systemctl", "daemon-reload"); err != nil {
+		return fmt.Errorf("failed to reload systemd: %v, output: %s", err, string(out))
+	}
+
+	if out, err := m.host.Runner.Run(ctx, "systemctl", "enable", UnitName); err != nil {
+		return fmt.Errorf("failed to enable %s: %v, output: %s", UnitName, err, string(out))
+	}
+
+	return nil
+}
+
+// removeUnit disables and removes the systemd unit setting the level on boot.
+func (m *Manager) removeUnit(ctx context.Context) error {
+	if _, err := os.Stat(m.unitPath); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	if out, err := m.host.Runner.Run(ctx, "systemctl", "disable", UnitName); err != nil {
+		return fmt.Errorf("failed to disable %s: %v, output: %s", UnitName, err, string(out))
+	}
+
+	if err := os.Remove(m.unitPath); err != nil {
+		return fmt.Errorf("failed to remove %s: %w", m.unitPath, err)
+	}
+
+	if out, err := m.host.Runner.Run(ctx, "systemctl", "daemon-reload"); err != nil {
+		return fmt.Errorf("failed to reload systemd: %v, output: %s", err, string(out))
+	}
+
+	return nil
+}
+
+// ValidateLevel verifies that the SMT level is supported.
+func ValidateLevel(level int) error {
+	if level < MinLevel || level > MaxLevel {
+		return fmt.Errorf("invalid SMT level %d: must be between %d and %d", level, MinLevel, MaxLevel)
+	}
+
+	return nil
+}
+
+// conflict returns a ConflictError if an application other than the given one requires a different level.
+func conflict(state *State, level int, app string) error {
+	for _, name := range slices.Sorted(maps.Keys(state.Applications)) {
+		if name != app && state.Applications[name] != level {
+			return &ConflictError{App: name, Level: state.Applications[name]}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package smt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+)
+
+// fakeCPU stands in for ppc64_cpu and systemctl, keeping the SMT level set and the commands run.
+type fakeCPU struct {
+	level    int
+	commands []string
+}
+
+func (f *fakeCPU) Run(_ context.Context, name string, args ...string) ([]byte, error) {
+	command := strings.Join(append([]string{name}, args...), " ")
+	f.commands = append(f.commands, command)
+
+	switch {
+	case command == "ppc64_cpu --smt" && f.level == 1:
+		return []byte("SMT is off\n"), nil
+	case command == "ppc64_cpu --smt":
+		return fmt.Appendf(nil, "SMT=%d\n", f.level), nil
+	case strings.HasPrefix(command, "ppc64_cpu --smt="):
+		level, err := strconv.Atoi(strings.TrimPrefix(command, "ppc64_cpu --smt="))
+		if err != nil {
+			return []byte("invalid SMT level"), err
+		}
+		f.level = level
+
+		return nil, nil
+	case name == "systemctl":
+		return nil, nil
+	}
+
+	return nil, fmt.Errorf("unexpected command %s", command)
+}
+
+func (f *fakeCPU) LookPath(file string) (string, error) {
+	return "/usr/sbin/" + file, nil
+}
+
+// newTestManager returns a manager of a host at the given SMT level, storing its state in a temporary directory.
+func newTestManager(t *testing.T, level int) (*Manager, *fakeCPU) {
+	t.Helper()
+
+	cpu := &fakeCPU{level: level}
+	dir := t.TempDir()
+
+	return NewManagerWithPaths(&host.Host{Runner: cpu}, filepath.Join(dir, "smt.json"), filepath.Join(dir, UnitName)), cpu
+}
+
+func TestAcquireConflictingLevel(t *testing.T) {
+	m, cpu := newTestManager(t, 8)
+	if err := m.Acquire(t.Context(), "rag", 2); err != nil {
+		t.Fatal(err)
+	}
+
+	var conflict *ConflictError
+	if err := m.Acquire(t.Context(), "summarize", 4); !errors.As(err, &conflict) || conflict.App != "rag" || conflict.Level != 2 {
+		t.Fatalf("expected a conflict with rag at level 2, got: %v", err)
+	}
+	if cpu.level != 2 {
+		t.Errorf("expected the level to be kept at 2, got %d", cpu.level)
+	}
+
+	// the applications requiring the same level share it, which none of them can change then
+	if err := m.Acquire(t.Context(), "summarize", 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Acquire(t.Context(), "rag", 4); err == nil {
+		t.Fatal("expected a conflict with summarize at level 2")
+	}
+}
+
+func TestReleaseRestoresOriginalLevel(t *testing.T) {
+	m, cpu := newTestManager(t, 8)
+	for _, app := range []string{"rag", "summarize"} {
+		if err := m.Acquire(t.Context(), app, 2); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := m.Release(t.Context(), "rag"); err != nil {
+		t.Fatal(err)
+	}
+	if cpu.level != 2 {
+		t.Fatalf("expected the level to be kept while summarize requires it, got %d", cpu.level)
+	}
+
+	if err := m.Release(t.Context(), "summarize"); err != nil {
+		t.Fatal(err)
+	}
+	if cpu.level != 8 {
+		t.Errorf("expected the original level 8 to be restored, got %d", cpu.level)
+	}
+	if _, err := os.Stat(m.statePath); !os.IsNotExist(err) {
+		t.Errorf("expected the state to be removed, got: %v", err)
+	}
+}
+
+func TestRestoreAfterCrash(t *testing.T) {
+	m, cpu := newTestManager(t, 8)
+	if err := m.Set(t.Context(), 4, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Acquire(t.Context(), "rag", 4); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(m.unitPath); err != nil {
+		t.Fatalf("expected the unit to be installed, got: %v", err)
+	}
+
+	// a new manager picks up the state left behind by the crashed command
+	restarted := NewManagerWithPaths(&host.Host{Runner: cpu}, m.statePath, m.unitPath)
+	if err := restarted.Restore(t.Context(), false); err == nil || !strings.Contains(err.Error(), "rag") {
+		t.Fatalf("expected the restore to be refused while rag requires the level, got: %v", err)
+	}
+
+	cpu.commands = nil
+	if err := restarted.Restore(t.Context(), true); err != nil {
+		t.Fatal(err)
+	}
+	if cpu.level != 8 {
+		t.Errorf("expected the original level 8 to be restored, got %d", cpu.level)
+	}
+	if !slices.Contains(cpu.commands, "systemctl disable "+UnitName) {
+		t.Errorf("expected the unit to be disabled, ran %v", cpu.commands)
+	}
+	for _, path := range []string{m.unitPath, m.statePath} {
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Errorf("expected %s to be removed, got: %v", filepath.Base(path), err)
+		}
+	}
+}
@@ -0,0 +1,233 @@
+package spinner
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/term"
+)
+
+const (
+	refreshInterval = 100 * time.Millisecond
+
+	colorReset  = "\033[0m"
+	colorRed    = "\033[31m"
+	colorGreen  = "\033[32m"
+	colorYellow = "\033[33m"
+	colorGray   = "\033[90m"
+
+	// cursorUp moves the cursor up the given lines, clearLine clears the current line and clearBelow clears
+	// the rows left over from a previous longer render.
+	cursorUp   = "\033[%dA"
+	clearLine  = "\033[2K\r"
+	clearBelow = "\033[J"
+)
+
+var (
+	frames    = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+	ansiRegex = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+)
+
+type taskState int
+
+const (
+	taskPending taskState = iota
+	taskRunning
+	taskDone
+	taskWarned
+	taskFailed
+	taskSkipped
+)
+
+type task struct {
+	name    string
+	state   taskState
+	message string
+	hint    string
+}
+
+// Progress renders the live status of concurrent tasks, a line per task.
+// On a non terminal output, a line is printed once each task completes.
+type Progress struct {
+	mu       sync.Mutex
+	out      io.Writer
+	tty      bool
+	width    int
+	tasks    []*task
+	index    map[string]*task
+	frame    int
+	rendered int
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewProgress creates a progress view for the tasks with the given names, displayed in the given order.
+func NewProgress(names ...string) *Progress {
+	p := &Progress{out: os.Stdout, tty: isTerminal(os.Stdout), index: map[string]*task{}}
+	if width, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil {
+		p.width = width
+	}
+	for _, name := range names {
+		t := &task{name: name, message: name}
+		p.tasks = append(p.tasks, t)
+		p.index[name] = t
+	}
+
+	return p
+}
+
+// Start starts refreshing the view until Stop is called or the context is done.
+func (p *Progress) Start(ctx context.Context) {
+	if !p.tty {
+		return
+	}
+
+	ctx, p.cancel = context.WithCancel(ctx)
+	p.done = make(chan struct{})
+	go func() {
+		defer close(p.done)
+		ticker := time.NewTicker(refreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				p.mu.Lock()
+				p.frame = (p.frame + 1) % len(frames)
+				p.render()
+				p.mu.Unlock()
+			}
+		}
+	}()
+}
+
+// Stop stops refreshing and renders the final status of the tasks.
+func (p *Progress) Stop() {
+	if p.cancel != nil {
+		p.cancel()
+		<-p.done
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.tty {
+		p.render()
+	}
+}
+
+// Update marks the task as running with the given message.
+func (p *Progress) Update(name, message string) {
+	p.set(name, taskRunning, message, "")
+}
+
+// Done marks the task as completed successfully.
+func (p *Progress) Done(name, message string) {
+	p.set(name, taskDone, message, "")
+}
+
+// Warn marks the task as completed with a warning.
+func (p *Progress) Warn(name, message, hint string) {
+	p.set(name, taskWarned, message, hint)
+}
+
+// Fail marks the task as failed.
+func (p *Progress) Fail(name, message, hint string) {
+	p.set(name, taskFailed, message, hint)
+}
+
+// Skip marks the task as skipped.
+func (p *Progress) Skip(name, message string) {
+	p.set(name, taskSkipped, message, "")
+}
+
+func (p *Progress) set(name string, state taskState, message, hint string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	t, ok := p.index[name]
+	if !ok {
+		return
+	}
+	t.state, t.message, t.hint = state, message, hint
+
+	if !p.tty && state != taskRunning {
+		_, _ = fmt.Fprint(p.out, ansiRegex.ReplaceAllString(t.lines(""), ""))
+	}
+}
+
+// render redraws all the task lines in place of the previously rendered ones.
+func (p *Progress) render() {
+	var b strings.Builder
+	if p.rendered > 0 {
+		fmt.Fprintf(&b, cursorUp, p.rendered)
+	}
+
+	lines := 0
+	for _, t := range p.tasks {
+		text := t.lines(frames[p.frame])
+		for line := range strings.Lines(text) {
+			b.WriteString(clearLine)
+			b.WriteString(line)
+			lines += p.rows(line)
+		}
+	}
+	p.rendered = lines
+	b.WriteString(clearBelow)
+
+	_, _ = io.WriteString(p.out, b.String())
+}
+
+// rows returns the terminal rows taken by the line, as the long lines wrap.
+func (p *Progress) rows(line string) int {
+	length := utf8.RuneCountInString(strings.TrimSuffix(ansiRegex.ReplaceAllString(line, ""), "\n"))
+	if p.width <= 0 || length <= p.width {
+		return 1
+	}
+
+	return (length + p.width - 1) / p.width
+}
+
+// lines returns the status line of the task followed by its hint, if any.
+func (t *task) lines(frame string) string {
+	var symbol, color string
+	switch t.state {
+	case taskPending:
+		symbol, color = "•", colorGray
+	case taskRunning:
+		symbol, color = frame, ""
+	case taskDone:
+		symbol, color = "✔", colorGreen
+	case taskWarned:
+		symbol, color = "⚠", colorYellow
+	case taskFailed:
+		symbol, color = "✖", colorRed
+	case taskSkipped:
+		symbol, color = "-", colorGray
+	}
+
+	line := fmt.Sprintf("%s%s%s %s\n", color, symbol, colorReset, t.message)
+	if t.hint != "" {
+		line += fmt.Sprintf("  HINT: %s\n", t.hint)
+	}
+
+	return line
+}
+
+// isTerminal reports whether the file is a terminal.
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+
+	return fi.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,197 @@
+package support
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Redacted replaces the secrets and the tokens within the support bundle.
+const Redacted = "REDACTED"
+
+// valueSeparators end the unquoted values assigned using =, Eg:- within a command line or a query string.
+const valueSeparators = " \t,;&"
+
+// sensitiveSuffixes are the suffixes of the normalized keys holding secrets, Eg:- HF_TOKEN, apiKey, db-password or the
+// Authorization header.
+var sensitiveSuffixes = []string{"token", "secret", "secrets", "secretkey", "password", "passwd", "apikey", "accesskey", "privatekey", "credential", "credentials", "authorization"}
+
+var (
+	// assignment matches the keys assigned a value, Eg:- KEY=VALUE within the env of a container or a command line and
+	// key: value within yaml, json or logs. The value follows the match.
+	assignment = regexp.MustCompile(`\b([A-Za-z_][A-Za-z0-9_.-]*)["']?[ \t]*([:=])[ \t]*`)
+	// flagArgument matches the flags followed by their argument, Eg:- --hf-token <value>
+	flagArgument = regexp.MustCompile(`(^|\s)(--?[A-Za-z][A-Za-z0-9_-]*)([ \t]+)("[^"\n]*"|'[^'\n]*'|[^\s"'-]\S*)`)
+	// knownTokens matches the tokens recognizable by their format wherever they appear, along with the credentials of
+	// the authorization schemes, Eg:- Bearer <token> or Basic <credentials>
+	knownTokens = regexp.MustCompile(`\b(hf_[A-Za-z0-9]{16,}|gh[pousr]_[A-Za-z0-9]{20,})\b|(?i)\b((?:bearer|basic)\s+)[A-Za-z0-9._~+/=-]+`)
+)
+
+// isSensitive reports whether the key names a secret.
+func isSensitive(key string) bool {
+	normalized := strings.Map(func(r rune) rune {
+		if r == '_' || r == '-' || r == '.' {
+			return -1
+		}
+
+		return r
+	}, strings.ToLower(key))
+
+	for _, suffix := range sensitiveSuffixes {
+		if strings.HasSuffix(normalized, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RedactText redacts the values assigned to the sensitive keys, the arguments of the sensitive flags and the known
+// tokens within free text, such as logs.
+func RedactText(data []byte) []byte {
+	data = redactAssignments(data)
+
+	data = flagArgument.ReplaceAllFunc(data, func(match []byte) []byte {
+		parts := flagArgument.FindSubmatch(match)
+		if !isSensitive(strings.TrimLeft(string(parts[2]), "-")) {
+			return match
+		}
+
+		return slices.Concat(parts[1], parts[2], parts[3], []byte(Redacted))
+	})
+
+	return knownTokens.ReplaceAllFunc(data, func(match []byte) []byte {
+		if parts := knownTokens.FindSubmatch(match); len(parts[2]) > 0 {
+			return append(parts[2], Redacted...)
+		}
+
+		return []byte(Redacted)
+	})
+}
+
+// redactAssignments redacts the values assigned to the sensitive keys. A quoted value is redacted up to its closing
+// quote, keeping the quotes. An unquoted value is redacted up to the next separator when assigned using =, Eg:- within
+// a command line, else up to the end of the line, Eg:- password: my secret phrase.
+func redactAssignments(data []byte) []byte {
+	var out []byte
+	last := 0
+	for _, m := range assignment.FindAllSubmatchIndex(data, -1) {
+		// the keys within a value already redacted are skipped
+		if m[0] < last || !isSensitive(string(data[m[2]:m[3]])) {
+			continue
+		}
+
+		start, end, quoted := valueBounds(data, m[1], data[m[4]] == '=')
+		if start == end && !quoted {
+			// nothing is assigned, Eg:- a yaml mapping holding the secrets under it
+			continue
+		}
+		out = append(append(out, data[last:start]...), Redacted...)
+		last = end
+	}
+
+	return append(out, data[last:]...)
+}
+
+// valueBounds returns the bounds of the value starting at offset, excluding its quotes if quoted.
+func valueBounds(data []byte, offset int, assigned bool) (int, int, bool) {
+	lineEnd := len(data)
+	if i := bytes.IndexAny(data[offset:], "\r\n"); i >= 0 {
+		lineEnd = offset + i
+	}
+	if offset == lineEnd {
+		return offset, offset, false
+	}
+
+	if quote := data[offset]; quote == '"' || quote == '\'' {
+		if i := bytes.IndexByte(data[offset+1:lineEnd], quote); i >= 0 {
+			return offset + 1, offset + 1 + i, true
+		}
+
+		// the value is not closed on the line, it is redacted up to the end of the line
+		return offset, lineEnd, false
+	}
+
+	if !assigned {
+		return offset, lineEnd, false
+	}
+	if i := bytes.IndexAny(data[offset:lineEnd], valueSeparators); i >= 0 {
+		return offset, offset + i, false
+	}
+
+	return offset, lineEnd, false
+}
+
+// RedactValue redacts the secrets within a decoded json or yaml document.
+// The scalars under a sensitive key are redacted, along with the value of the {name, value} pairs naming a sensitive
+// key, such as the env of a pod. The strings are redacted as free text.
+func RedactValue(value any) any {
+	return redactValue(value, false)
+}
+
+func redactValue(value any, sensitive bool) any {
+	switch v := value.(type) {
+	case map[string]any:
+		name, _ := v["name"].(string)
+		for key, item := range v {
+			v[key] = redactValue(item, sensitive || isSensitive(key) || (key == "value" && isSensitive(name)))
+		}
+
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = redactValue(item, sensitive)
+		}
+
+		return v
+	case string:
+		if sensitive && v != "" {
+			return Redacted
+		}
+
+		return string(RedactText([]byte(v)))
+	case nil:
+		return nil
+	default:
+		if sensitive {
+			return Redacted
+		}
+
+		return v
+	}
+}
+
+// RedactJSON encodes the value as indented json with its secrets redacted.
+func RedactJSON(value any) ([]byte, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var generic any
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(RedactValue(generic), "", "  ")
+}
+
+// RedactYAML redacts the secrets within the yaml document. Falls back to redacting it as free text if the document
+// cannot be decoded, Eg:- for the multi document files.
+func RedactYAML(data []byte) []byte {
+	var generic any
+	if err := yaml.Unmarshal(data, &generic); err != nil || generic == nil {
+		return RedactText(data)
+	}
+
+	redacted, err := yaml.Marshal(RedactValue(generic))
+	if err != nil {
+		return RedactText(data)
+	}
+
+	return redacted
+}
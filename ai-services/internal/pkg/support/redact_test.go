@@ -0,0 +1,147 @@
+package support
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRedactText(t *testing.T) {
+	tests := map[string]struct {
+		in, want string
+	}{
+		"unquoted env assignment": {
+			in:   "HF_TOKEN=abc123 MODEL=granite",
+			want: "HF_TOKEN=REDACTED MODEL=granite",
+		},
+		"double quoted env assignment": {
+			in:   `API_KEY="sk-abc def" DEBUG=1`,
+			want: `API_KEY="REDACTED" DEBUG=1`,
+		},
+		"single quoted env assignment": {
+			in:   "DB_PASSWORD='hunter2'",
+			want: "DB_PASSWORD='REDACTED'",
+		},
+		"value with spaces up to the end of the line": {
+			in:   "password: my secret phrase\nuser: admin",
+			want: "password: REDACTED\nuser: admin",
+		},
+		"json within a log line": {
+			in:   `request {"apiKey": "sk-1, 2", "model": "granite"}`,
+			want: `request {"apiKey": "REDACTED", "model": "granite"}`,
+		},
+		"query string": {
+			in:   "GET /v1?access_token=abc&model=granite",
+			want: "GET /v1?access_token=REDACTED&model=granite",
+		},
+		"basic authorization header": {
+			in:   "Authorization: Basic dXNlcjpwYXNz",
+			want: "Authorization: REDACTED",
+		},
+		"custom authorization scheme": {
+			in:   "proxy-authorization: Negotiate YIIabc",
+			want: "proxy-authorization: REDACTED",
+		},
+		"bearer credentials outside a header": {
+			in:   "curl -H 'X-Auth: Bearer abc.def'",
+			want: "curl -H 'X-Auth: Bearer REDACTED'",
+		},
+		"token flag argument": {
+			in:   "ai-services model download --hf-token hf_secret granite",
+			want: "ai-services model download --hf-token REDACTED granite",
+		},
+		"token flag assignment": {
+			in:   "vllm serve --api-key=sk-abc --port 8000",
+			want: "vllm serve --api-key=REDACTED --port 8000",
+		},
+		"known token in free text": {
+			in:   "using hf_abcdefghijklmnopqrstuvwxyz for the download",
+			want: "using REDACTED for the download",
+		},
+		"mapping without a value": {
+			in:   "secrets:\n  name: app",
+			want: "secrets:\n  name: app",
+		},
+		"non sensitive keys": {
+			in:   "tokens_per_second: 42 max_tokens=512",
+			want: "tokens_per_second: 42 max_tokens=512",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := string(RedactText([]byte(tt.in))); got != tt.want {
+				t.Errorf("RedactText(%q)\n got: %q\nwant: %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactYAML(t *testing.T) {
+	in := `env:
+- name: HF_TOKEN
+  value: hf_secret
+- name: MODEL
+  value: granite
+auth:
+  password: my secret phrase
+  user: admin
+command: vllm serve --api-key sk-abc
+`
+	want := `auth:
+  password: REDACTED
+  user: admin
+command: vllm serve --api-key REDACTED
+env:
+- name: HF_TOKEN
+  value: REDACTED
+- name: MODEL
+  value: granite
+`
+	if got := string(RedactYAML([]byte(in))); got != want {
+		t.Errorf("unexpected redacted yaml:\n%s", got)
+	}
+
+	// the documents which cannot be decoded are redacted as free text
+	multi := "password: abc\n---\npassword: def\n"
+	if got := string(RedactYAML([]byte(multi))); strings.Contains(got, "abc") || strings.Contains(got, "def") {
+		t.Errorf("expected the multi document yaml to be redacted, got:\n%s", got)
+	}
+}
+
+func TestRedactJSON(t *testing.T) {
+	value := map[string]any{
+		"Config": map[string]any{
+			"Env":    []string{"HF_TOKEN=hf_secret", "MODEL=granite"},
+			"Labels": map[string]string{"db-password": "hunter2", "app": "rag"},
+		},
+		"Retries": 3,
+		"ApiKey":  42,
+	}
+
+	data, err := RedactJSON(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got struct {
+		Config struct {
+			Env    []string
+			Labels map[string]string
+		}
+		Retries any
+		ApiKey  any
+	}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Config.Env[0] != "HF_TOKEN=REDACTED" || got.Config.Env[1] != "MODEL=granite" {
+		t.Errorf("unexpected env %v", got.Config.Env)
+	}
+	if got.Config.Labels["db-password"] != Redacted || got.Config.Labels["app"] != "rag" {
+		t.Errorf("unexpected labels %v", got.Config.Labels)
+	}
+	if got.ApiKey != Redacted || got.Retries != float64(3) {
+		t.Errorf("expected only the sensitive scalars to be redacted, got ApiKey %v and Retries %v", got.ApiKey, got.Retries)
+	}
+}
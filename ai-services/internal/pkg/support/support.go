@@ -0,0 +1,276 @@
+package support
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/docker/go-units"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/sysinfo"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/servicereport"
+)
+
+const (
+	// Version is the version of the support bundle layout.
+	Version = 1
+	// DefaultMaxLogSize is the default size the logs of each container are capped at.
+	DefaultMaxLogSize = 10 * units.MiB
+
+	manifestFile    = "manifest.json"
+	hostDir         = "host"
+	appsDir         = "applications"
+	filePermissions = 0o600
+)
+
+// Manifest describes the content of a support bundle. It is the last entry of the bundle archive.
+type Manifest struct {
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"createdAt"`
+	// Applications are the applications collected
+	Applications []string `json:"applications"`
+	Files        []File   `json:"files"`
+	// Errors holds the items which could not be collected along with the reason
+	Errors []Error `json:"errors,omitempty"`
+}
+
+// File is a file within the support bundle.
+type File struct {
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+	// Truncated is set if the file is capped, only its end is kept
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// Error is an item which could not be collected.
+type Error struct {
+	Item  string `json:"item"`
+	Error string `json:"error"`
+}
+
+// Bundle is a support bundle archive being written. The secrets of the collected files are redacted.
+type Bundle struct {
+	output   string
+	file     *os.File
+	gz       *gzip.Writer
+	tw       *tar.Writer
+	manifest Manifest
+}
+
+// Create creates the support bundle archive at the given path. The archive is written to a temporary file until
+// closed so that an incomplete bundle is never left behind.
+func Create(output string) (*Bundle, error) {
+	f, err := os.OpenFile(output+".partial", os.O_CREATE|os.O_TRUNC|os.O_WRONLY, filePermissions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create support bundle: %w", err)
+	}
+	gz := gzip.NewWriter(f)
+
+	return &Bundle{
+		output:   output,
+		file:     f,
+		gz:       gz,
+		tw:       tar.NewWriter(gz),
+		manifest: Manifest{Version: Version, CreatedAt: time.Now(), Applications: []string{}, Files: []File{}},
+	}, nil
+}
+
+// Manifest returns the manifest of the files collected so far.
+func (b *Bundle) Manifest() Manifest {
+	return b.manifest
+}
+
+// AddJSON adds the value as json to the bundle with its secrets redacted.
+func (b *Bundle) AddJSON(name string, value any) {
+	data, err := RedactJSON(value)
+	if err != nil {
+		b.AddError(name, fmt.Errorf("failed to encode: %w", err))
+
+		return
+	}
+	b.add(name, data, false)
+}
+
+// AddText adds the free text to the bundle with its secrets redacted.
+func (b *Bundle) AddText(name string, data []byte) {
+	b.add(name, RedactText(data), false)
+}
+
+// AddError records the item which could not be collected in the manifest.
+func (b *Bundle) AddError(item string, err error) {
+	logger.Infof("failed to collect %s: %v\n", item, err, logger.VerbosityLevelDebug)
+	b.manifest.Errors = append(b.manifest.Errors, Error{Item: item, Error: err.Error()})
+}
+
+func (b *Bundle) add(name string, data []byte, truncated bool) {
+	if err := writeEntry(b.tw, name, data); err != nil {
+		b.AddError(name, err)
+
+		return
+	}
+	b.manifest.Files = append(b.manifest.Files, File{Path: name, Size: int64(len(data)), Truncated: truncated})
+}
+
+// Close writes the manifest and moves the bundle archive into place.
+func (b *Bundle) Close() error {
+	partial := b.file.Name()
+	defer os.Remove(partial)
+	defer b.file.Close()
+
+	data, err := json.MarshalIndent(b.manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode support bundle manifest: %w", err)
+	}
+	if err := writeEntry(b.tw, manifestFile, data); err != nil {
+		return err
+	}
+
+	for _, closer := range []interface{ Close() error }{b.tw, b.gz, b.file} {
+		if err := closer.Close(); err != nil {
+			return fmt.Errorf("failed to write support bundle: %w", err)
+		}
+	}
+
+	if err := os.Rename(partial, b.output); err != nil {
+		return fmt.Errorf("failed to create support bundle: %w", err)
+	}
+
+	return nil
+}
+
+// Discard removes the support bundle being written.
+func (b *Bundle) Discard() {
+	_ = b.file.Close()
+	_ = os.Remove(b.file.Name())
+}
+
+func writeEntry(tw *tar.Writer, name string, data []byte) error {
+	hdr := &tar.Header{Name: name, Mode: filePermissions, Size: int64(len(data)), ModTime: time.Now(), Typeflag: tar.TypeReg}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return fmt.Errorf("failed to write %s to the support bundle: %w", name, err)
+	}
+
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("failed to write %s to the support bundle: %w", name, err)
+	}
+
+	return nil
+}
+
+// CollectHost adds the summary of the host, the output of the ServiceReport tool and the host configuration
+// recorded by ai-services to the bundle.
+func (b *Bundle) CollectHost(ctx context.Context, h *host.Host, rt runtime.Runtime) {
+	b.AddJSON(path.Join(hostDir, "system-info.json"), sysinfo.Collect(ctx, h, rt))
+
+	out, err := helpers.ServiceReportOutput(ctx, h.Runner, servicereport.ValidateCommand, "validate")
+	if len(out) > 0 {
+		b.AddText(path.Join(hostDir, "servicereport.txt"), out)
+	}
+	if err != nil {
+		b.AddError("servicereport", err)
+	}
+
+	for name, hostPath := range map[string]string{
+		"configure-journal.jsonl": constants.ConfigureJournalPath,
+		"smt.json":                constants.SMTStatePath,
+	} {
+		b.addHostFile(path.Join(hostDir, name), hostPath, RedactText)
+	}
+}
+
+// CollectApplication adds the pods, the containers along with their logs capped at maxLogSize, the values,
+// the rendered pod templates and the validation report of the application to the bundle.
+func (b *Bundle) CollectApplication(ctx context.Context, rt runtime.Runtime, app string, maxLogSize int64) {
+	b.manifest.Applications = append(b.manifest.Applications, app)
+	dir := path.Join(appsDir, app)
+
+	if rt != nil {
+		b.collectPods(ctx, rt, app, dir, maxLogSize)
+	}
+
+	appDir := filepath.Join(constants.ApplicationsPath, app)
+	b.addHostFile(path.Join(dir, constants.ValuesFile), filepath.Join(appDir, constants.ValuesFile), RedactYAML)
+	b.addHostFile(path.Join(dir, constants.ValidationReportFile), filepath.Join(appDir, constants.ValidationReportFile), RedactText)
+
+	renderedDir := filepath.Join(appDir, constants.RenderedTemplatesDir)
+	entries, err := os.ReadDir(renderedDir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		b.AddError(path.Join(dir, constants.RenderedTemplatesDir), err)
+	}
+	for _, entry := range entries {
+		if entry.Type().IsRegular() {
+			b.addHostFile(path.Join(dir, constants.RenderedTemplatesDir, entry.Name()), filepath.Join(renderedDir, entry.Name()), RedactYAML)
+		}
+	}
+}
+
+func (b *Bundle) collectPods(ctx context.Context, rt runtime.Runtime, app, dir string, maxLogSize int64) {
+	pods, err := rt.ListPods(ctx, map[string][]string{"label": {fmt.Sprintf("%s=%s", constants.ApplicationAnnotationKey, app)}})
+	if err != nil {
+		b.AddError(path.Join(dir, "pods"), fmt.Errorf("failed to list pods: %w", err))
+
+		return
+	}
+
+	for _, pod := range pods {
+		if ctx.Err() != nil {
+			b.AddError(path.Join(dir, "pods"), ctx.Err())
+
+			return
+		}
+
+		podFile := path.Join(dir, "pods", pod.Name+".json")
+		if report, err := rt.InspectPod(ctx, pod.ID); err != nil {
+			b.AddError(podFile, err)
+		} else {
+			b.AddJSON(podFile, report)
+		}
+
+		for _, container := range pod.Containers {
+			b.collectContainer(ctx, rt, dir, container, maxLogSize)
+		}
+	}
+}
+
+func (b *Bundle) collectContainer(ctx context.Context, rt runtime.Runtime, dir string, container runtime.Container, maxLogSize int64) {
+	inspectFile := path.Join(dir, "containers", container.Name+".json")
+	if data, err := rt.InspectContainer(ctx, container.ID); err != nil {
+		b.AddError(inspectFile, err)
+	} else {
+		b.AddJSON(inspectFile, data)
+	}
+
+	logFile := path.Join(dir, "logs", container.Name+".log")
+	logs := utils.NewTailBuffer(maxLogSize)
+	if err := rt.FetchContainerLogs(ctx, container.ID, logs); err != nil {
+		b.AddError(logFile, err)
+	}
+	b.add(logFile, RedactText(logs.Bytes()), logs.Truncated())
+}
+
+// addHostFile adds the host file to the bundle, redacted by the given function. Missing files are skipped.
+func (b *Bundle) addHostFile(name, hostPath string, redact func([]byte) []byte) {
+	data, err := os.ReadFile(hostPath)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			b.AddError(name, err)
+		}
+
+		return
+	}
+	b.add(name, redact(data), false)
+}
@@ -0,0 +1,239 @@
+package sysinfo
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/smt"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/disk"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/memory"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/numa"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/platform"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/podmanversion"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/power"
+)
+
+// Names of the probes gathering the sections of the report, used to report the probes which failed.
+const (
+	ProbePower        = "power"
+	ProbeOS           = "os"
+	ProbeNUMA         = "numa"
+	ProbeSMT          = "smt"
+	ProbeSpyre        = "spyre"
+	ProbePodman       = "podman"
+	ProbeMemory       = "memory"
+	ProbeDisk         = "disk"
+	ProbeApplications = "applications"
+
+	podmanSocket = "podman.socket"
+)
+
+// Info is the summary of the host gathered for a support case.
+type Info struct {
+	Arch string `json:"arch"`
+	// Power is the processor generation, Eg:- POWER11
+	Power        string         `json:"power,omitempty"`
+	OS           OS             `json:"os"`
+	NUMA         *numa.Topology `json:"numa,omitempty"`
+	SMT          SMT            `json:"smt"`
+	Spyre        []SpyreCard    `json:"spyre"`
+	Podman       Podman         `json:"podman"`
+	Memory       Memory         `json:"memory"`
+	Disk         Disk           `json:"disk"`
+	Applications []Application  `json:"applications"`
+	// Errors holds the error of each probe which failed, the sections gathered by them are left empty
+	Errors map[string]string `json:"errors,omitempty"`
+}
+
+// OS is the operating system of the host.
+type OS struct {
+	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+// SMT is the SMT level of the host along with the level before ai-services changed it.
+type SMT struct {
+	Current  int `json:"current,omitempty"`
+	Original int `json:"original,omitempty"`
+}
+
+// SpyreCard is a Spyre card attached to the host along with the kernel driver it is bound to.
+type SpyreCard struct {
+	Address string `json:"address"`
+	Driver  string `json:"driver,omitempty"`
+}
+
+// Podman is the podman server and the state of its API socket.
+type Podman struct {
+	Version    string `json:"version,omitempty"`
+	APIVersion string `json:"apiVersion,omitempty"`
+	// Socket is the state of the podman socket unit, Eg:- active
+	Socket string `json:"socket,omitempty"`
+}
+
+// Memory is the memory of the host in bytes.
+type Memory struct {
+	Total     uint64 `json:"total,omitempty"`
+	Available uint64 `json:"available,omitempty"`
+}
+
+// Disk is the free space in bytes of the filesystem holding the data path.
+type Disk struct {
+	Path string `json:"path"`
+	Free uint64 `json:"free,omitempty"`
+}
+
+// Application is an application deployed on the host along with the number of its pods.
+type Application struct {
+	Name string `json:"name"`
+	Pods int    `json:"pods"`
+}
+
+// Collect gathers the summary of the host. The applications are listed using the runtime, skipped if it is nil.
+// A probe failing does not stop the others, its error is recorded in the report instead.
+func Collect(ctx context.Context, h *host.Host, rt runtime.Runtime) *Info {
+	info := &Info{
+		Arch:         h.Arch,
+		Spyre:        []SpyreCard{},
+		Disk:         Disk{Path: constants.DataPath},
+		Applications: []Application{},
+		Errors:       map[string]string{},
+	}
+	record := func(probe string, err error) {
+		if err != nil {
+			info.Errors[probe] = err.Error()
+		}
+	}
+
+	var err error
+	info.Power, err = power.Generation(h.FS)
+	record(ProbePower, err)
+
+	info.OS.Name, info.OS.Version, err = platform.Release(h.FS)
+	record(ProbeOS, err)
+
+	info.NUMA, err = numa.ReadTopology(ctx, h.Runner)
+	record(ProbeNUMA, err)
+
+	record(ProbeSMT, collectSMT(ctx, h, &info.SMT))
+
+	if cards, err := spyreCards(ctx, h.Runner); err != nil {
+		record(ProbeSpyre, err)
+	} else {
+		info.Spyre = cards
+	}
+
+	record(ProbePodman, collectPodman(ctx, h.Runner, &info.Podman))
+
+	record(ProbeMemory, collectMemory(h.FS, &info.Memory))
+
+	info.Disk.Free, err = disk.FreeSpace(h.FS, info.Disk.Path)
+	record(ProbeDisk, err)
+
+	if rt != nil {
+		if apps, err := applications(ctx, rt); err != nil {
+			record(ProbeApplications, err)
+		} else {
+			info.Applications = apps
+		}
+	}
+
+	return info
+}
+
+func collectSMT(ctx context.Context, h *host.Host, s *SMT) error {
+	manager := smt.NewManager(h)
+	current, err := manager.Current(ctx)
+	if err != nil {
+		return err
+	}
+	s.Current = current
+
+	state, err := manager.State()
+	if err != nil {
+		return err
+	}
+	s.Original = state.Original
+
+	return nil
+}
+
+// spyreCards returns the Spyre cards attached to the host along with their driver.
+func spyreCards(ctx context.Context, runner host.CommandRunner) ([]SpyreCard, error) {
+	addresses, err := helpers.ListSpyreCards(ctx, runner)
+	if err != nil {
+		return nil, err
+	}
+
+	drivers, err := helpers.SpyreCardDrivers(ctx, runner)
+	if err != nil {
+		return nil, err
+	}
+
+	cards := make([]SpyreCard, 0, len(addresses))
+	for _, address := range addresses {
+		cards = append(cards, SpyreCard{Address: address, Driver: drivers[address]})
+	}
+
+	return cards, nil
+}
+
+func collectPodman(ctx context.Context, runner host.CommandRunner, p *Podman) error {
+	// is-active exits non-zero for the inactive units, its output holds the state either way unless systemd is
+	// not reachable, in which case the output is an error message
+	out, _ := runner.Run(ctx, "systemctl", "is-active", podmanSocket)
+	if state := strings.TrimSpace(string(out)); state != "" && !strings.ContainsAny(state, " \n") {
+		p.Socket = state
+	}
+
+	server, err := podmanversion.ServerVersion(ctx, runner)
+	if err != nil {
+		return err
+	}
+	p.Version, p.APIVersion = server.Version, server.APIVersion
+
+	return nil
+}
+
+func collectMemory(hostFS host.FS, m *Memory) error {
+	total, err := memory.TotalMemory(hostFS)
+	if err != nil {
+		return err
+	}
+
+	available, err := memory.AvailableMemory(hostFS)
+	if err != nil {
+		return err
+	}
+	m.Total, m.Available = total, available
+
+	return nil
+}
+
+// applications returns the applications deployed on the host sorted by their name.
+func applications(ctx context.Context, rt runtime.Runtime) ([]Application, error) {
+	pods, err := rt.ListPods(ctx, map[string][]string{"label": {constants.ApplicationAnnotationKey}})
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int{}
+	for _, pod := range pods {
+		if app := pod.Labels[constants.ApplicationAnnotationKey]; app != "" {
+			counts[app]++
+		}
+	}
+
+	apps := make([]Application, 0, len(counts))
+	for _, name := range slices.Sorted(maps.Keys(counts)) {
+		apps = append(apps, Application{Name: name, Pods: counts[name]})
+	}
+
+	return apps, nil
+}
@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"fmt"
+	"io"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/table"
@@ -10,10 +12,14 @@ import (
 
 const (
 	columnPadding = 2
+	// headerHeight is the height of the headers along with their border.
+	headerHeight = 2
 )
 
 type Printer struct {
 	model table.Model
+	// plain keeps every value as is, without the header border nor collapsing the repeated values of the first column.
+	plain bool
 }
 
 func NewTableWriter() *Printer {
@@ -41,6 +47,24 @@ func NewTableWriter() *Printer {
 	return &Printer{model: t}
 }
 
+// NewPlainTableWriter returns a printer rendering the rows as plain columns, Eg:- for the custom columns consumed by
+// the scripts.
+func NewPlainTableWriter() *Printer {
+	t := table.New(
+		table.WithColumns([]table.Column{}),
+		table.WithRows([]table.Row{}),
+		table.WithFocused(false),
+	)
+
+	t.SetStyles(table.Styles{
+		Header:   lipgloss.NewStyle().Bold(false),
+		Cell:     lipgloss.NewStyle(),
+		Selected: lipgloss.NewStyle(),
+	})
+
+	return &Printer{model: t, plain: true}
+}
+
 func (p *Printer) SetHeaders(headers ...string) {
 	cols := make([]table.Column, len(headers))
 
@@ -81,8 +105,26 @@ func collapseFirstColumn(rows []table.Row) []table.Row {
 }
 
 func (p *Printer) CloseTableWriter() {
+	for _, line := range p.render() {
+		logger.Infoln(line)
+	}
+}
+
+// Render writes the table to w rather than to the logs, Eg:- to keep it on the same stream as the escape sequences
+// refreshing the terminal.
+func (p *Printer) Render(w io.Writer) {
+	for _, line := range p.render() {
+		fmt.Fprintln(w, line)
+	}
+}
+
+// render returns the non-empty lines of the table and resets its rows.
+func (p *Printer) render() []string {
 	cols := p.model.Columns()
-	rows := collapseFirstColumn(p.model.Rows())
+	rows := p.model.Rows()
+	if !p.plain {
+		rows = collapseFirstColumn(rows)
+	}
 
 	// Width of rows is computed here before rendering
 	for colIdx := range cols {
@@ -100,14 +142,22 @@ func (p *Printer) CloseTableWriter() {
 	}
 
 	p.model.SetColumns(cols)
+	// render all the rows rather than the default height of the table
+	p.model.SetHeight(len(rows) + headerHeight)
 
 	out := p.model.View()
 
+	var lines []string
 	for line := range strings.SplitSeq(out, "\n") {
+		if p.plain {
+			line = strings.TrimRight(line, " ")
+		}
 		if strings.TrimSpace(line) != "" {
-			logger.Infoln(line)
+			lines = append(lines, line)
 		}
 	}
 
 	p.model.SetRows([]table.Row{})
+
+	return lines
 }
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -13,7 +14,9 @@ type BackoffFunc func(currentDelay time.Duration) time.Duration
 // Retry -> retries based on the retry attempts and initialDelay time set on failure.
 // Does exponentialBackOff based on the provided BackoffFunc.
 // Set backoff func to nil, if exponentialBackoff is not required.
+// Stops retrying as soon as the provided context is cancelled.
 func Retry(
+	ctx context.Context,
 	attempts int,
 	initialDelay time.Duration,
 	backoff BackoffFunc,
@@ -29,6 +32,10 @@ func Retry(
 	}
 
 	for i := range attempts {
+		if ctx.Err() != nil {
+			return fmt.Errorf("retry cancelled: %w", context.Cause(ctx))
+		}
+
 		logger.Infof("\n[Retry] Attempt %d/%d...\n", i+1, attempts, 0)
 
 		if err = fn(); err == nil {
@@ -42,7 +49,11 @@ func Retry(
 
 		// Sleep till delay
 		logger.Infof("[Retry] Sleeping %v before retrying...\n", delay, logger.VerbosityLevelDebug)
-		time.Sleep(delay)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("retry cancelled: %w", context.Cause(ctx))
+		case <-time.After(delay):
+		}
 
 		// Apply backoff if provided
 		if backoff != nil {
@@ -0,0 +1,45 @@
+package utils
+
+// TailBuffer keeps the last bytes written to it up to its limit, Eg:- the end of the logs being the most relevant.
+type TailBuffer struct {
+	limit     int64
+	data      []byte
+	truncated bool
+}
+
+// NewTailBuffer returns a buffer keeping the last limit bytes written to it.
+func NewTailBuffer(limit int64) *TailBuffer {
+	return &TailBuffer{limit: limit}
+}
+
+func (t *TailBuffer) Write(p []byte) (int, error) {
+	t.data = append(t.data, p...)
+	// trim once twice the limit is reached to avoid copying on every write
+	if int64(len(t.data)) > 2*t.limit {
+		t.trim()
+	}
+
+	return len(p), nil
+}
+
+func (t *TailBuffer) trim() {
+	if int64(len(t.data)) <= t.limit {
+		return
+	}
+	t.data = append(t.data[:0:0], t.data[int64(len(t.data))-t.limit:]...)
+	t.truncated = true
+}
+
+// Bytes returns the last bytes written, up to the limit.
+func (t *TailBuffer) Bytes() []byte {
+	t.trim()
+
+	return t.data
+}
+
+// Truncated reports whether the bytes written exceeded the limit.
+func (t *TailBuffer) Truncated() bool {
+	t.trim()
+
+	return t.truncated
+}
@@ -2,9 +2,11 @@ package utils
 
 import (
 	"fmt"
+	"io/fs"
 	"maps"
 	"net"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
@@ -97,6 +99,25 @@ func ParseKeyValues(pairs []string) (map[string]string, error) {
 	return out, nil
 }
 
+// DirSize returns the total size of the files under the directory, 0 if it does not exist.
+func DirSize(dir string) uint64 {
+	var size uint64
+	_ = filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			// skip the unreadable entries
+			return nil
+		}
+		if info, err := d.Info(); err == nil {
+			//nolint:gosec // file sizes are never negative
+			size += uint64(info.Size())
+		}
+
+		return nil
+	})
+
+	return size
+}
+
 func FileExists(path string) bool {
 	_, err := os.Stat(path)
 	if err == nil {
@@ -0,0 +1,95 @@
+package disk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/docker/go-units"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+// dataMinFreeSpace is the free space recommended under the data path for the images, models and application data.
+const dataMinFreeSpace = 100 * units.GiB
+
+// DiskRule validates that the filesystem holding the path has the required free space.
+type DiskRule struct {
+	name     string
+	path     string
+	required uint64
+	level    constants.ValidationLevel
+	host     *host.Host
+}
+
+// NewDiskRule creates a rule with the given name requiring the given bytes of free space under the path.
+// The path need not exist yet, in which case its closest existing parent is checked.
+func NewDiskRule(h *host.Host, name, path string, required uint64) *DiskRule {
+	return &DiskRule{host: h, name: name, path: path, required: required, level: constants.ValidationLevelError}
+}
+
+// NewDataDiskRule creates a rule warning if the free space under the data path is below the recommended space.
+// The space required by an application is verified as part of its host requirements during create.
+func NewDataDiskRule(h *host.Host) *DiskRule {
+	return &DiskRule{host: h, name: "disk", path: constants.DataPath, required: dataMinFreeSpace, level: constants.ValidationLevelWarning}
+}
+
+func (r *DiskRule) Name() string {
+	return r.name
+}
+
+func (r *DiskRule) Description() string {
+	return fmt.Sprintf("Validates that there is enough free disk space under %s.", r.path)
+}
+
+func (r *DiskRule) Verify(ctx context.Context) error {
+	logger.Infof("Validating free disk space under %s...", r.path, logger.VerbosityLevelDebug)
+	free, err := FreeSpace(r.host.FS, r.path)
+	if err != nil {
+		return err
+	}
+
+	if free < r.required {
+		return fmt.Errorf("insufficient disk space under %s: required %s, free %s (short by %s)",
+			r.path, units.BytesSize(float64(r.required)), units.BytesSize(float64(free)), units.BytesSize(float64(r.required-free)))
+	}
+
+	return nil
+}
+
+func (r *DiskRule) Message() string {
+	return fmt.Sprintf("At least %s of disk space is free under %s", units.BytesSize(float64(r.required)), r.path)
+}
+
+func (r *DiskRule) Level() constants.ValidationLevel {
+	return r.level
+}
+
+func (r *DiskRule) Hint() string {
+	return fmt.Sprintf("Free up disk space or extend the filesystem holding %s", r.path)
+}
+
+// FreeSpace returns the bytes available to unprivileged users on the filesystem holding the path.
+// The path need not exist yet, in which case its closest existing parent is checked.
+func FreeSpace(hostFS host.FS, path string) (uint64, error) {
+	path = filepath.Clean(path)
+	for {
+		var stat syscall.Statfs_t
+		err := hostFS.Statfs(path, &stat)
+		if err == nil {
+			//nolint:gosec,unconvert // block size is always positive, type differs across architectures
+			return stat.Bavail * uint64(stat.Bsize), nil
+		}
+
+		parent := filepath.Dir(path)
+		if !errors.Is(err, os.ErrNotExist) || parent == path {
+			return 0, fmt.Errorf("failed to check disk space of %s: %w", path, err)
+		}
+		path = parent
+	}
+}
@@ -0,0 +1,304 @@
+package external
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+// RulesDir is the directory from which the site specific validation rules are loaded.
+const RulesDir = "/etc/ai-services/validators.d"
+
+const (
+	commandTimeout = 30 * time.Second
+	dialTimeout    = 2 * time.Second
+
+	portStateFree      = "free"
+	portStateListening = "listening"
+)
+
+// rule names are used within the comma separated --skip-validation flag, which is matched case insensitively.
+var ruleNameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// RulesFile is the format of a rules file under RulesDir.
+//
+// Eg:-
+//
+//	rules:
+//	  - name: models-share
+//	    description: Validates that the NFS model share is mounted.
+//	    level: error
+//	    hint: Mount the model share using `mount -a`
+//	    command: mountpoint -q /var/lib/ai-services/models
+type RulesFile struct {
+	Rules []Spec `yaml:"rules"`
+}
+
+// Spec defines a declarative validation rule. Exactly one of the checks must be set.
+type Spec struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	// Level is either 'error' (default) or 'warning'
+	Level string `yaml:"level,omitempty"`
+	Hint  string `yaml:"hint,omitempty"`
+	// Message is displayed once the rule passes, defaults to the description
+	Message string `yaml:"message,omitempty"`
+
+	// Command passes if the shell command exits with code 0
+	Command      string       `yaml:"command,omitempty"`
+	File         *FileCheck   `yaml:"file,omitempty"`
+	Sysctl       *SysctlCheck `yaml:"sysctl,omitempty"`
+	KernelModule string       `yaml:"kernelModule,omitempty"`
+	Port         *PortCheck   `yaml:"port,omitempty"`
+}
+
+// FileCheck passes if the file exists and contains the given text, if any.
+type FileCheck struct {
+	Path     string `yaml:"path"`
+	Contains string `yaml:"contains,omitempty"`
+}
+
+// SysctlCheck passes if the kernel parameter equals the value or is at least the min value.
+type SysctlCheck struct {
+	Key   string `yaml:"key"`
+	Value string `yaml:"value,omitempty"`
+	Min   *int64 `yaml:"min,omitempty"`
+}
+
+// PortCheck passes if the port is in the given state: 'free' (default) or 'listening'.
+type PortCheck struct {
+	Port     int    `yaml:"port"`
+	Protocol string `yaml:"protocol,omitempty"`
+	State    string `yaml:"state,omitempty"`
+}
+
+// Rule is a validation rule loaded from a rules file.
+type Rule struct {
+	spec Spec
+}
+
+// Load reads all the *.yaml files under the given directory and returns the rules defined in them.
+// Invalid files are skipped and reported in the returned error. Returns no rules if the directory does not exist.
+func Load(dir string) ([]*Rule, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rules files: %w", err)
+	}
+
+	var (
+		rules []*Rule
+		errs  []error
+	)
+	for _, file := range files {
+		fileRules, err := loadFile(file)
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+		rules = append(rules, fileRules...)
+	}
+
+	return rules, errors.Join(errs...)
+}
+
+func loadFile(path string) ([]*Rule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rules file %s: %w", path, err)
+	}
+
+	var rulesFile RulesFile
+	if err := yaml.Unmarshal(data, &rulesFile); err != nil {
+		return nil, fmt.Errorf("failed to parse rules file %s: %w", path, err)
+	}
+
+	rules := make([]*Rule, 0, len(rulesFile.Rules))
+	for _, spec := range rulesFile.Rules {
+		if err := validateSpec(spec); err != nil {
+			return nil, fmt.Errorf("invalid rule in %s: %w", path, err)
+		}
+		rules = append(rules, &Rule{spec: spec})
+	}
+
+	return rules, nil
+}
+
+func validateSpec(spec Spec) error {
+	if !ruleNameRegex.MatchString(spec.Name) {
+		return fmt.Errorf("invalid rule name '%s': must match %s", spec.Name, ruleNameRegex)
+	}
+
+	if spec.Level != "" && spec.Level != "error" && spec.Level != "warning" {
+		return fmt.Errorf("rule '%s': invalid level '%s': must be error or warning", spec.Name, spec.Level)
+	}
+
+	checks := 0
+	for _, set := range []bool{spec.Command != "", spec.File != nil, spec.Sysctl != nil, spec.KernelModule != "", spec.Port != nil} {
+		if set {
+			checks++
+		}
+	}
+	if checks != 1 {
+		return fmt.Errorf("rule '%s': exactly one of command, file, sysctl, kernelModule or port must be set", spec.Name)
+	}
+
+	if spec.Port != nil {
+		if spec.Port.State != "" && spec.Port.State != portStateFree && spec.Port.State != portStateListening {
+			return fmt.Errorf("rule '%s': invalid port state '%s': must be %s or %s", spec.Name, spec.Port.State, portStateFree, portStateListening)
+		}
+	}
+
+	return nil
+}
+
+func (r *Rule) Name() string {
+	return r.spec.Name
+}
+
+func (r *Rule) Description() string {
+	return r.spec.Description
+}
+
+func (r *Rule) Verify(ctx context.Context) error {
+	logger.Infof("Validating external rule: %s", r.spec.Name, logger.VerbosityLevelDebug)
+
+	switch {
+	case r.spec.Command != "":
+		return verifyCommand(ctx, r.spec.Command)
+	case r.spec.File != nil:
+		return verifyFile(*r.spec.File)
+	case r.spec.Sysctl != nil:
+		return verifySysctl(*r.spec.Sysctl)
+	case r.spec.KernelModule != "":
+		return verifyKernelModule(r.spec.KernelModule)
+	default:
+		return verifyPort(*r.spec.Port)
+	}
+}
+
+func (r *Rule) Message() string {
+	if r.spec.Message != "" {
+		return r.spec.Message
+	}
+
+	return r.spec.Description
+}
+
+func (r *Rule) Level() constants.ValidationLevel {
+	if r.spec.Level == "warning" {
+		return constants.ValidationLevelWarning
+	}
+
+	return constants.ValidationLevelError
+}
+
+func (r *Rule) Hint() string {
+	return r.spec.Hint
+}
+
+func verifyCommand(ctx context.Context, command string) error {
+	ctx, cancel := context.WithTimeout(ctx, commandTimeout)
+	defer cancel()
+
+	out, err := exec.CommandContext(ctx, "bash", "-c", command).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("command '%s' failed: %v, output: %s", command, err, strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
+
+func verifyFile(check FileCheck) error {
+	data, err := os.ReadFile(check.Path)
+	if err != nil {
+		return fmt.Errorf("file %s is not readable: %w", check.Path, err)
+	}
+
+	if check.Contains != "" && !strings.Contains(string(data), check.Contains) {
+		return fmt.Errorf("file %s does not contain '%s'", check.Path, check.Contains)
+	}
+
+	return nil
+}
+
+func verifySysctl(check SysctlCheck) error {
+	path := filepath.Join("/proc/sys", strings.ReplaceAll(check.Key, ".", "/"))
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read sysctl %s: %w", check.Key, err)
+	}
+	// multi valued parameters are tab separated, normalize them to single spaces
+	value := strings.Join(strings.Fields(string(data)), " ")
+
+	if check.Min != nil {
+		current, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("sysctl %s has a non numeric value '%s'", check.Key, value)
+		}
+		if current < *check.Min {
+			return fmt.Errorf("sysctl %s is set to %d, required at least %d", check.Key, current, *check.Min)
+		}
+	}
+
+	if check.Value != "" && value != strings.Join(strings.Fields(check.Value), " ") {
+		return fmt.Errorf("sysctl %s is set to '%s', required '%s'", check.Key, value, check.Value)
+	}
+
+	return nil
+}
+
+func verifyKernelModule(module string) error {
+	// loaded modules are listed with underscores in their names
+	if _, err := os.Stat(filepath.Join("/sys/module", strings.ReplaceAll(module, "-", "_"))); err != nil {
+		return fmt.Errorf("kernel module %s is not loaded", module)
+	}
+
+	return nil
+}
+
+func verifyPort(check PortCheck) error {
+	protocol := check.Protocol
+	if protocol == "" {
+		protocol = "tcp"
+	}
+	address := net.JoinHostPort("", strconv.Itoa(check.Port))
+
+	if check.State == portStateListening {
+		conn, err := net.DialTimeout(protocol, net.JoinHostPort("localhost", strconv.Itoa(check.Port)), dialTimeout)
+		if err != nil {
+			return fmt.Errorf("nothing is listening on %s port %d", protocol, check.Port)
+		}
+
+		return conn.Close()
+	}
+
+	if strings.HasPrefix(protocol, "udp") {
+		conn, err := net.ListenPacket(protocol, address)
+		if err != nil {
+			return fmt.Errorf("%s port %d is already in use", protocol, check.Port)
+		}
+
+		return conn.Close()
+	}
+
+	listener, err := net.Listen(protocol, address)
+	if err != nil {
+		return fmt.Errorf("%s port %d is already in use", protocol, check.Port)
+	}
+
+	return listener.Close()
+}
@@ -0,0 +1,100 @@
+package hugepages
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/docker/go-units"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+const (
+	memInfoPath = "/proc/meminfo"
+	kibibyte    = 1024
+)
+
+// HugePagesRule validates that no static huge pages are reserved, as the reserved memory is not available to
+// the application containers which use regular pages.
+type HugePagesRule struct {
+	host *host.Host
+}
+
+func NewHugePagesRule(h *host.Host) *HugePagesRule {
+	return &HugePagesRule{host: h}
+}
+
+func (r *HugePagesRule) Name() string {
+	return "hugepages"
+}
+
+func (r *HugePagesRule) Description() string {
+	return "Validates that no static huge pages are reserved out of the memory required by the applications."
+}
+
+func (r *HugePagesRule) Verify(ctx context.Context) error {
+	logger.Infoln("Validating huge pages...", logger.VerbosityLevelDebug)
+	data, err := r.host.FS.ReadFile(memInfoPath)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", memInfoPath, err)
+	}
+
+	info, err := parseMemInfo(data)
+	if err != nil {
+		return err
+	}
+
+	total := info["HugePages_Total"]
+	if total == 0 {
+		return nil
+	}
+
+	// Hugepagesize is reported in kB
+	reserved := total * info["Hugepagesize"] * kibibyte
+
+	return fmt.Errorf("%d static huge pages are reserved, holding %s of memory", total, units.BytesSize(float64(reserved)))
+}
+
+func (r *HugePagesRule) Message() string {
+	return "No static huge pages are reserved"
+}
+
+func (r *HugePagesRule) Level() constants.ValidationLevel {
+	return constants.ValidationLevelWarning
+}
+
+func (r *HugePagesRule) Hint() string {
+	return "Release the huge pages using `sysctl -w vm.nr_hugepages=0` and remove the hugepages kernel arguments if not used by other workloads"
+}
+
+// parseMemInfo returns the numeric values of /proc/meminfo, the sizes are in kB.
+func parseMemInfo(data []byte) (map[string]uint64, error) {
+	info := map[string]uint64{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		// Eg:- HugePages_Total:       0
+		key, value, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+
+		fields := strings.Fields(value)
+		if len(fields) == 0 {
+			continue
+		}
+
+		n, err := strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s in %s: %w", key, memInfoPath, err)
+		}
+		info[key] = n
+	}
+
+	return info, nil
+}
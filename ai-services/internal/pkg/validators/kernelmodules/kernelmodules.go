@@ -0,0 +1,83 @@
+package kernelmodules
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+const sysModulePath = "/sys/module"
+
+// KernelModulesRule validates that the kernel modules are loaded.
+type KernelModulesRule struct {
+	host    *host.Host
+	modules []string
+}
+
+func NewKernelModulesRule(h *host.Host, modules []string) *KernelModulesRule {
+	return &KernelModulesRule{host: h, modules: modules}
+}
+
+func (r *KernelModulesRule) Name() string {
+	return "kernel-modules"
+}
+
+func (r *KernelModulesRule) Description() string {
+	return "Validates that the kernel modules required by the application are loaded."
+}
+
+func (r *KernelModulesRule) Verify(ctx context.Context) error {
+	logger.Infoln("Validating kernel modules...", logger.VerbosityLevelDebug)
+	if missing := r.missing(); len(missing) > 0 {
+		return fmt.Errorf("kernel module(s) not loaded: %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+func (r *KernelModulesRule) Message() string {
+	return "Kernel modules are loaded: " + strings.Join(r.modules, ", ")
+}
+
+func (r *KernelModulesRule) Level() constants.ValidationLevel {
+	return constants.ValidationLevelError
+}
+
+func (r *KernelModulesRule) Hint() string {
+	return "Load the kernel modules using `modprobe <module>`"
+}
+
+func (r *KernelModulesRule) CanFix() bool {
+	_, err := r.host.Runner.LookPath("modprobe")
+
+	return err == nil
+}
+
+// Fix loads the missing kernel modules.
+func (r *KernelModulesRule) Fix(ctx context.Context) error {
+	for _, module := range r.missing() {
+		out, err := r.host.Runner.Run(ctx, "modprobe", module)
+		if err != nil {
+			return fmt.Errorf("failed to load kernel module %s: %v, output: %s", module, err, string(out))
+		}
+	}
+
+	return nil
+}
+
+func (r *KernelModulesRule) missing() []string {
+	var missing []string
+	for _, module := range r.modules {
+		// loaded modules are listed with underscores in their names
+		if _, err := r.host.FS.Stat(filepath.Join(sysModulePath, strings.ReplaceAll(module, "-", "_"))); err != nil {
+			missing = append(missing, module)
+		}
+	}
+
+	return missing
+}
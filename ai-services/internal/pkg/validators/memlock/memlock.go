@@ -0,0 +1,184 @@
+package memlock
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+const (
+	// containersConfDir holds the drop-in containers.conf files, overriding the containers.conf files.
+	containersConfDir = "/etc/containers/containers.conf.d"
+	// podmanService is the unit serving the podman API the applications are deployed through.
+	podmanService = "podman.service"
+	memlockUlimit = "memlock"
+	unlimited     = "unlimited"
+)
+
+// containersConfFiles are the containers.conf files, in the order they override each other.
+var containersConfFiles = []string{"/usr/share/containers/containers.conf", "/etc/containers/containers.conf"}
+
+// MemlockRule validates that the locked memory limit the containers are started with is unlimited, as the vfio
+// devices pin the memory of the containers using them. The limit is set by default_ulimits in containers.conf, else
+// inherited from the podman service.
+type MemlockRule struct {
+	host *host.Host
+}
+
+func NewMemlockRule(h *host.Host) *MemlockRule {
+	return &MemlockRule{host: h}
+}
+
+func (r *MemlockRule) Name() string {
+	return "memlock"
+}
+
+func (r *MemlockRule) Description() string {
+	return "Validates that the containers are started with the unlimited locked memory limit required by vfio."
+}
+
+func (r *MemlockRule) Verify(ctx context.Context) error {
+	logger.Infoln("Validating locked memory limit of the containers...", logger.VerbosityLevelDebug)
+	soft, hard, source, err := r.containersMemlock(ctx)
+	if err != nil {
+		return err
+	}
+
+	if soft != unlimited || hard != unlimited {
+		return fmt.Errorf("containers are started with a locked memory limit of %s (soft) / %s (hard) bytes from %s, required: unlimited", soft, hard, source)
+	}
+
+	return nil
+}
+
+func (r *MemlockRule) Message() string {
+	return "Containers locked memory limit is unlimited"
+}
+
+func (r *MemlockRule) Level() constants.ValidationLevel {
+	return constants.ValidationLevelWarning
+}
+
+func (r *MemlockRule) Hint() string {
+	return `Set default_ulimits = ["memlock=-1:-1"] under [containers] in /etc/containers/containers.conf`
+}
+
+// containersMemlock returns the soft and the hard locked memory limits the containers are started with, along with
+// where they are set.
+func (r *MemlockRule) containersMemlock(ctx context.Context) (string, string, string, error) {
+	soft, hard, source, err := r.containersConfMemlock()
+	if err != nil || source != "" {
+		return soft, hard, source, err
+	}
+
+	out, err := r.host.Runner.Run(ctx, "systemctl", "show", podmanService, "--property=LimitMEMLOCK", "--property=LimitMEMLOCKSoft")
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to read the locked memory limit of %s: %w", podmanService, err)
+	}
+	soft, hard, err = parseServiceMemlock(out)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return soft, hard, podmanService, nil
+}
+
+// containersConfMemlock returns the locked memory limits set by default_ulimits in the containers.conf files, an
+// empty source if none of them sets it.
+func (r *MemlockRule) containersConfMemlock() (string, string, string, error) {
+	files := slices.Clone(containersConfFiles)
+	entries, err := r.host.FS.ReadDir(containersConfDir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", "", "", fmt.Errorf("failed to list %s: %w", containersConfDir, err)
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".conf") {
+			files = append(files, path.Join(containersConfDir, entry.Name()))
+		}
+	}
+
+	var soft, hard, source string
+	for _, file := range files {
+		data, err := r.host.FS.ReadFile(file)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return "", "", "", fmt.Errorf("failed to read %s: %w", file, err)
+		}
+
+		var conf struct {
+			Containers struct {
+				DefaultUlimits []string `toml:"default_ulimits"`
+			} `toml:"containers"`
+		}
+		if _, err := toml.Decode(string(data), &conf); err != nil {
+			return "", "", "", fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+		for _, ulimit := range conf.Containers.DefaultUlimits {
+			name, value, _ := strings.Cut(ulimit, "=")
+			if strings.TrimSpace(name) != memlockUlimit {
+				continue
+			}
+			soft, hard = parseUlimit(value)
+			source = file
+		}
+	}
+
+	return soft, hard, source, nil
+}
+
+// parseUlimit returns the soft and the hard limits of the ulimit value in the form soft[:hard], Eg:- -1:-1.
+func parseUlimit(value string) (string, string) {
+	soft, hard, found := strings.Cut(strings.TrimSpace(value), ":")
+	if !found {
+		hard = soft
+	}
+
+	return normalize(soft), normalize(hard)
+}
+
+// parseServiceMemlock returns the soft and the hard locked memory limits from the properties of the unit.
+// Eg:- LimitMEMLOCK=8388608.
+func parseServiceMemlock(data []byte) (string, string, error) {
+	properties := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		if key, value, ok := strings.Cut(scanner.Text(), "="); ok {
+			properties[key] = normalize(value)
+		}
+	}
+
+	hard, ok := properties["LimitMEMLOCK"]
+	if !ok {
+		return "", "", fmt.Errorf("locked memory limit of %s not found", podmanService)
+	}
+	soft, ok := properties["LimitMEMLOCKSoft"]
+	if !ok {
+		soft = hard
+	}
+
+	return soft, hard, nil
+}
+
+// normalize returns unlimited for the values meaning no limit, Eg:- -1 in containers.conf and infinity for systemd.
+func normalize(value string) string {
+	switch value = strings.TrimSpace(value); value {
+	case "-1", "infinity", unlimited:
+		return unlimited
+	default:
+		return value
+	}
+}
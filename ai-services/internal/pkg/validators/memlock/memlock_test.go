@@ -0,0 +1,113 @@
+package memlock
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/host/hosttest"
+)
+
+const (
+	serviceCommand = "systemctl show podman.service --property=LimitMEMLOCK --property=LimitMEMLOCKSoft"
+	etcConf        = "/etc/containers/containers.conf"
+)
+
+// newTestRule returns the rule of the host recorded by the fixture.
+func newTestRule(t *testing.T, fixture hosttest.Fixture) *MemlockRule {
+	t.Helper()
+
+	h, err := fixture.Host()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewMemlockRule(h)
+}
+
+func TestContainersMemlock(t *testing.T) {
+	limitedService := map[string]hosttest.CommandResult{serviceCommand: {Output: "LimitMEMLOCK=8388608\nLimitMEMLOCKSoft=65536\n"}}
+
+	tests := map[string]struct {
+		fixture                  hosttest.Fixture
+		wantSoft, wantHard, from string
+	}{
+		"soft and hard limits of containers.conf": {
+			fixture:  hosttest.Fixture{Files: map[string]string{etcConf: "[containers]\ndefault_ulimits = [\"nofile=1024:2048\", \"memlock=-1:-1\"]\n"}},
+			wantSoft: unlimited, wantHard: unlimited, from: etcConf,
+		},
+		"single limit of containers.conf": {
+			fixture:  hosttest.Fixture{Files: map[string]string{etcConf: "[containers]\ndefault_ulimits = [\"memlock=65536\"]\n"}},
+			wantSoft: "65536", wantHard: "65536", from: etcConf,
+		},
+		"containers.conf over the podman service": {
+			fixture: hosttest.Fixture{
+				Files:    map[string]string{"/usr/share/containers/containers.conf": "[containers]\ndefault_ulimits = [\"memlock=-1\"]\n"},
+				Commands: limitedService,
+			},
+			wantSoft: unlimited, wantHard: unlimited, from: "/usr/share/containers/containers.conf",
+		},
+		"drop-in over containers.conf": {
+			fixture: hosttest.Fixture{Files: map[string]string{
+				etcConf: "[containers]\ndefault_ulimits = [\"memlock=-1:-1\"]\n",
+				"/etc/containers/containers.conf.d/10-limit.conf": "[containers]\ndefault_ulimits = [\"memlock=65536:131072\"]\n",
+			}},
+			wantSoft: "65536", wantHard: "131072", from: "/etc/containers/containers.conf.d/10-limit.conf",
+		},
+		"podman service without a containers.conf limit": {
+			fixture: hosttest.Fixture{
+				Files:    map[string]string{etcConf: "[containers]\ndefault_ulimits = [\"nofile=1024:2048\"]\n"},
+				Commands: limitedService,
+			},
+			wantSoft: "65536", wantHard: "8388608", from: podmanService,
+		},
+		"podman service with an infinite limit": {
+			fixture:  hosttest.Fixture{Commands: map[string]hosttest.CommandResult{serviceCommand: {Output: "LimitMEMLOCK=infinity\n"}}},
+			wantSoft: unlimited, wantHard: unlimited, from: podmanService,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			soft, hard, from, err := newTestRule(t, tt.fixture).containersMemlock(t.Context())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if soft != tt.wantSoft || hard != tt.wantHard || from != tt.from {
+				t.Errorf("expected %s:%s from %s, got %s:%s from %s", tt.wantSoft, tt.wantHard, tt.from, soft, hard, from)
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	unlimitedConf := hosttest.Fixture{Files: map[string]string{etcConf: "[containers]\ndefault_ulimits = [\"memlock=-1:-1\"]\n"}}
+	if err := newTestRule(t, unlimitedConf).Verify(t.Context()); err != nil {
+		t.Errorf("expected the unlimited limit to pass, failed: %v", err)
+	}
+
+	limitedService := hosttest.Fixture{Commands: map[string]hosttest.CommandResult{serviceCommand: {Output: "LimitMEMLOCK=8388608\n"}}}
+	err := newTestRule(t, limitedService).Verify(t.Context())
+	if err == nil || !strings.Contains(err.Error(), "8388608 (soft) / 8388608 (hard) bytes from podman.service") {
+		t.Errorf("expected the limit of the podman service to be reported, got: %v", err)
+	}
+}
+
+func TestVerifyUnreadableLimits(t *testing.T) {
+	fixtures := map[string]hosttest.Fixture{
+		"failed to read the locked memory limit of podman.service": {
+			Commands: map[string]hosttest.CommandResult{serviceCommand: {ExitCode: 1}},
+		},
+		"locked memory limit of podman.service not found": {
+			Commands: map[string]hosttest.CommandResult{serviceCommand: {Output: "LimitNOFILE=1024\n"}},
+		},
+		"failed to parse " + etcConf: {
+			Files: map[string]string{etcConf: "[containers\n"},
+		},
+	}
+
+	for wantErr, fixture := range fixtures {
+		if err := newTestRule(t, fixture).Verify(t.Context()); err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Errorf("expected the verification to fail with '%s', got: %v", wantErr, err)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package memory
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/docker/go-units"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+const (
+	memInfoPath = "/proc/meminfo"
+	kibibyte    = 1024
+)
+
+// MemoryRule validates that the host has the required memory available.
+type MemoryRule struct {
+	host     *host.Host
+	required uint64
+}
+
+// NewMemoryRule creates a rule requiring the given bytes of available memory.
+func NewMemoryRule(h *host.Host, required uint64) *MemoryRule {
+	return &MemoryRule{host: h, required: required}
+}
+
+func (r *MemoryRule) Name() string {
+	return "memory"
+}
+
+func (r *MemoryRule) Description() string {
+	return "Validates that the LPAR has enough available memory for the application."
+}
+
+func (r *MemoryRule) Verify(ctx context.Context) error {
+	logger.Infoln("Validating available memory...", logger.VerbosityLevelDebug)
+	available, err := AvailableMemory(r.host.FS)
+	if err != nil {
+		return err
+	}
+
+	if available < r.required {
+		return fmt.Errorf("insufficient memory: required %s, available %s (short by %s)",
+			units.BytesSize(float64(r.required)), units.BytesSize(float64(available)), units.BytesSize(float64(r.required-available)))
+	}
+
+	return nil
+}
+
+func (r *MemoryRule) Message() string {
+	return fmt.Sprintf("LPAR has at least %s of available memory", units.BytesSize(float64(r.required)))
+}
+
+func (r *MemoryRule) Level() constants.ValidationLevel {
+	return constants.ValidationLevelError
+}
+
+func (r *MemoryRule) Hint() string {
+	return "Free up memory by stopping unused applications or increase the memory assigned to the LPAR"
+}
+
+// AvailableMemory returns the MemAvailable value from /proc/meminfo in bytes.
+func AvailableMemory(hostFS host.FS) (uint64, error) {
+	return memInfoValue(hostFS, "MemAvailable")
+}
+
+// TotalMemory returns the MemTotal value from /proc/meminfo in bytes.
+func TotalMemory(hostFS host.FS) (uint64, error) {
+	return memInfoValue(hostFS, "MemTotal")
+}
+
+// memInfoValue returns the value of the field from /proc/meminfo in bytes.
+func memInfoValue(hostFS host.FS, field string) (uint64, error) {
+	data, err := hostFS.ReadFile(memInfoPath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read %s: %w", memInfoPath, err)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		// Eg:- MemAvailable:   12345678 kB
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != field+":" {
+			continue
+		}
+
+		kb, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse %s: %w", field, err)
+		}
+
+		return kb * kibibyte, nil
+	}
+
+	return 0, fmt.Errorf("%s not found in %s", field, memInfoPath)
+}
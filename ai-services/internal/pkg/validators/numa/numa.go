@@ -1,19 +1,22 @@
 package numa
 
 import (
+	"context"
 	"fmt"
-	"os/exec"
 	"strconv"
 	"strings"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 )
 
-type NumaRule struct{}
+type NumaRule struct {
+	host *host.Host
+}
 
-func NewNumaRule() *NumaRule {
-	return &NumaRule{}
+func NewNumaRule(h *host.Host) *NumaRule {
+	return &NumaRule{host: h}
 }
 
 func (r *NumaRule) Name() string {
@@ -24,27 +27,65 @@ func (r *NumaRule) Description() string {
 	return "Validates that the NUMA node alignment on LPAR is set to 1 for optimal performance."
 }
 
-func (r *NumaRule) Verify() error {
+func (r *NumaRule) Verify(ctx context.Context) error {
 	logger.Infoln("Validating NUMA node alignment on LPAR", logger.VerbosityLevelDebug)
-	cmd := `lscpu | grep -i "NUMA node(s)"`
-	out, err := exec.Command("bash", "-c", cmd).Output()
+	topology, err := ReadTopology(ctx, r.host.Runner)
 	if err != nil {
-		return fmt.Errorf("failed to execute lscpu command: %w", err)
+		return err
+	}
+	numaCount := topology.Nodes
+
+	if numaCount != 1 {
+		return fmt.Errorf(`current NUMA node configuration (%d) is not aligned for maximum efficiency. For optimal performance, ensure that all CPUs are aligned to a single NUMA node`, numaCount)
 	}
 
-	fields := strings.Fields(string(out))
+	return nil
+}
+
+// Topology is the NUMA layout of the LPAR as reported by lscpu.
+type Topology struct {
+	Nodes int `json:"nodes"`
+	// CPUs holds the CPU list of each NUMA node, Eg:- node0: 0-79
+	CPUs map[string]string `json:"cpus,omitempty"`
+}
+
+// ReadTopology returns the NUMA layout of the LPAR.
+func ReadTopology(ctx context.Context, runner host.CommandRunner) (*Topology, error) {
+	out, err := runner.Run(ctx, "lscpu")
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute lscpu command: %w", err)
+	}
+
+	fields := numaNodeFields(string(out))
 	if len(fields) == 0 {
-		return fmt.Errorf("failed to get NUMA node fields")
+		return nil, fmt.Errorf("failed to get NUMA node fields")
 	}
 
-	numaVal := fields[len(fields)-1]
-	numaCount, err := strconv.Atoi(numaVal)
+	numaCount, err := strconv.Atoi(fields[len(fields)-1])
 	if err != nil {
-		return fmt.Errorf("error extracting numa count: %w", err)
+		return nil, fmt.Errorf("error extracting numa count: %w", err)
 	}
 
-	if numaCount != 1 {
-		return fmt.Errorf(`current NUMA node configuration (%d) is not aligned for maximum efficiency. For optimal performance, ensure that all CPUs are aligned to a single NUMA node`, numaCount)
+	topology := &Topology{Nodes: numaCount, CPUs: map[string]string{}}
+	for line := range strings.SplitSeq(string(out), "\n") {
+		// Eg:- NUMA node0 CPU(s):   0-79
+		key, value, ok := strings.Cut(line, ":")
+		if !ok || !strings.HasPrefix(key, "NUMA node") || !strings.HasSuffix(key, "CPU(s)") {
+			continue
+		}
+		node := strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(key, "NUMA "), "CPU(s)"))
+		topology.CPUs[node] = strings.TrimSpace(value)
+	}
+
+	return topology, nil
+}
+
+// numaNodeFields returns the fields of the "NUMA node(s):" line of the lscpu output.
+func numaNodeFields(out string) []string {
+	for line := range strings.SplitSeq(out, "\n") {
+		if strings.Contains(strings.ToLower(line), "numa node(s)") {
+			return strings.Fields(line)
+		}
 	}
 
 	return nil
@@ -1,19 +1,22 @@
 package platform
 
 import (
+	"context"
 	"fmt"
-	"os"
 	"strconv"
 	"strings"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 )
 
-type PlatformRule struct{}
+type PlatformRule struct {
+	host *host.Host
+}
 
-func NewPlatformRule() *PlatformRule {
-	return &PlatformRule{}
+func NewPlatformRule(h *host.Host) *PlatformRule {
+	return &PlatformRule{host: h}
 }
 
 func (r *PlatformRule) Name() string {
@@ -24,10 +27,10 @@ func (r *PlatformRule) Description() string {
 	return "Validates that the operating system is RHEL version 9.6 or higher."
 }
 
-func (r *PlatformRule) Verify() error {
+func (r *PlatformRule) Verify(ctx context.Context) error {
 	logger.Infoln("Validating operating system...", logger.VerbosityLevelDebug)
 
-	data, err := os.ReadFile("/etc/os-release")
+	data, err := r.host.FS.ReadFile("/etc/os-release")
 	if err != nil {
 		return err
 	}
@@ -63,6 +66,31 @@ func (r *PlatformRule) Verify() error {
 	return nil
 }
 
+// Release returns the name and the version of the operating system from /etc/os-release, Eg:- RHEL 9.6.
+func Release(hostFS host.FS) (string, string, error) {
+	data, err := hostFS.ReadFile("/etc/os-release")
+	if err != nil {
+		return "", "", err
+	}
+
+	osInfo := string(data)
+	version, err := fetchRhelVersion(osInfo)
+	if err != nil {
+		return "", "", err
+	}
+
+	name := ""
+	for line := range strings.SplitSeq(osInfo, "\n") {
+		if value, ok := strings.CutPrefix(line, "NAME="); ok {
+			name = strings.Trim(value, `"`)
+
+			break
+		}
+	}
+
+	return name, version, nil
+}
+
 // fetchRhelVersion -> fetches the Rhel version from /etc/os-release.
 func fetchRhelVersion(osInfo string) (string, error) {
 	idx := strings.Index(osInfo, "VERSION_ID=")
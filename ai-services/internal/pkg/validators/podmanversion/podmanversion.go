@@ -0,0 +1,185 @@
+package podmanversion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver/v4"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/connection"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+const (
+	// MinVersion is the minimum Podman version supported by ai-services.
+	MinVersion = "5.0.0"
+	// bindingsAPIMajor is the major version of the Podman API used by the ai-services runtime.
+	bindingsAPIMajor = 5
+)
+
+// PodmanRule validates that Podman meets the minimum version and that its API socket is reachable and compatible.
+type PodmanRule struct {
+	host *host.Host
+}
+
+func NewPodmanRule(h *host.Host) *PodmanRule {
+	return &PodmanRule{host: h}
+}
+
+func (r *PodmanRule) Name() string {
+	return "podman"
+}
+
+func (r *PodmanRule) Description() string {
+	return "Validates the Podman version and the compatibility of the Podman API socket."
+}
+
+func (r *PodmanRule) Verify(ctx context.Context) error {
+	logger.Infoln("Validating podman version and API socket...", logger.VerbosityLevelDebug)
+	server, err := ServerVersion(ctx, r.host.Runner)
+	if err != nil {
+		return err
+	}
+
+	if err := verifyMinVersion(server.Version, MinVersion); err != nil {
+		return err
+	}
+
+	apiVersion, err := semver.ParseTolerant(server.APIVersion)
+	if err != nil {
+		return fmt.Errorf("failed to parse podman API version '%s': %w", server.APIVersion, err)
+	}
+	if apiVersion.Major != bindingsAPIMajor {
+		return fmt.Errorf("podman API version %s is not compatible, required: %d.x", apiVersion, bindingsAPIMajor)
+	}
+
+	return nil
+}
+
+func (r *PodmanRule) Message() string {
+	return "Podman version and API socket are compatible"
+}
+
+func (r *PodmanRule) Level() constants.ValidationLevel {
+	return constants.ValidationLevelError
+}
+
+func (r *PodmanRule) Hint() string {
+	return fmt.Sprintf("Install podman %s or later using `dnf -y install podman` and enable its API socket using `systemctl enable --now podman.socket`", MinVersion)
+}
+
+// PodmanVersionRule validates that the Podman server version is at least the minimum version required by an application.
+type PodmanVersionRule struct {
+	host *host.Host
+	min  string
+}
+
+func NewPodmanVersionRule(h *host.Host, min string) *PodmanVersionRule {
+	return &PodmanVersionRule{host: h, min: min}
+}
+
+func (r *PodmanVersionRule) Name() string {
+	return "podman-version"
+}
+
+func (r *PodmanVersionRule) Description() string {
+	return "Validates that the Podman version meets the minimum version required."
+}
+
+func (r *PodmanVersionRule) Verify(ctx context.Context) error {
+	logger.Infoln("Validating podman version...", logger.VerbosityLevelDebug)
+	server, err := ServerVersion(ctx, r.host.Runner)
+	if err != nil {
+		return err
+	}
+
+	return verifyMinVersion(server.Version, r.min)
+}
+
+func (r *PodmanVersionRule) Message() string {
+	return "Podman version is at least " + r.min
+}
+
+func (r *PodmanVersionRule) Level() constants.ValidationLevel {
+	return constants.ValidationLevelError
+}
+
+func (r *PodmanVersionRule) Hint() string {
+	return "Upgrade podman using `dnf -y upgrade podman`"
+}
+
+// ComponentVersion is the version of the Podman client or server as reported by `podman version`.
+type ComponentVersion struct {
+	Version    string `json:"Version"`
+	APIVersion string `json:"APIVersion"`
+}
+
+// ServerVersion returns the version of the Podman server of the selected connection, queried over its API socket.
+// The connection is resolved the same way as the runtime does, Eg:- the --connection flag.
+func ServerVersion(ctx context.Context, runner host.CommandRunner) (*ComponentVersion, error) {
+	args, err := remoteArgs()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := runner.Run(ctx, "podman", append(args, "version", "--format", "json")...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach the podman API socket: %v, output: %s", err, strings.TrimSpace(string(out)))
+	}
+
+	var version struct {
+		Server *ComponentVersion `json:"Server"`
+	}
+	if err := json.Unmarshal(out, &version); err != nil {
+		return nil, fmt.Errorf("failed to parse podman version: %w", err)
+	}
+	if version.Server == nil {
+		return nil, fmt.Errorf("failed to get podman server version")
+	}
+
+	return version.Server, nil
+}
+
+// remoteArgs returns the podman flags to reach the API socket of the selected connection.
+// The local socket is reached with the podman defaults.
+func remoteArgs() ([]string, error) {
+	conn, err := connection.Resolve(vars.Connection)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []string{"--remote"}
+	if conn.URI == connection.LocalURI {
+		return args, nil
+	}
+
+	args = append(args, "--url", conn.URI)
+	if conn.Identity != "" {
+		args = append(args, "--identity", conn.Identity)
+	}
+
+	return args, nil
+}
+
+func verifyMinVersion(version, min string) error {
+	minVersion, err := semver.ParseTolerant(min)
+	if err != nil {
+		return fmt.Errorf("invalid minimum podman version '%s': %w", min, err)
+	}
+
+	current, err := semver.ParseTolerant(version)
+	if err != nil {
+		return fmt.Errorf("failed to parse podman version '%s': %w", version, err)
+	}
+
+	if current.LT(minVersion) {
+		return fmt.Errorf("podman version %s is older than the required version %s", current, minVersion)
+	}
+
+	return nil
+}
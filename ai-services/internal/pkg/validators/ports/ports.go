@@ -0,0 +1,71 @@
+package ports
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+// PortsRule validates that the host ports are free to be published.
+type PortsRule struct {
+	ports []int
+}
+
+func NewPortsRule(ports []int) *PortsRule {
+	return &PortsRule{ports: ports}
+}
+
+func (r *PortsRule) Name() string {
+	return "ports"
+}
+
+func (r *PortsRule) Description() string {
+	return "Validates that the host ports published by the application are free."
+}
+
+func (r *PortsRule) Verify(ctx context.Context) error {
+	logger.Infoln("Validating host ports...", logger.VerbosityLevelDebug)
+	var busy []string
+	for _, port := range BusyPorts(r.ports) {
+		busy = append(busy, strconv.Itoa(port))
+	}
+
+	if len(busy) > 0 {
+		return fmt.Errorf("host port(s) already in use: %s", strings.Join(busy, ", "))
+	}
+
+	return nil
+}
+
+// BusyPorts returns the ports which cannot be listened on as they are in use on the host.
+func BusyPorts(ports []int) []int {
+	var busy []int
+	for _, port := range ports {
+		listener, err := net.Listen("tcp", net.JoinHostPort("", strconv.Itoa(port)))
+		if err != nil {
+			busy = append(busy, port)
+
+			continue
+		}
+		_ = listener.Close()
+	}
+
+	return busy
+}
+
+func (r *PortsRule) Message() string {
+	return "Host ports are free"
+}
+
+func (r *PortsRule) Level() constants.ValidationLevel {
+	return constants.ValidationLevelError
+}
+
+func (r *PortsRule) Hint() string {
+	return "Stop the processes using the ports (see `ss -ltnp`) or override the ports using --params"
+}
@@ -1,19 +1,21 @@
 package power
 
 import (
+	"context"
 	"fmt"
-	"os"
-	"runtime"
 	"strings"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 )
 
-type PowerRule struct{}
+type PowerRule struct {
+	host *host.Host
+}
 
-func NewPowerRule() *PowerRule {
-	return &PowerRule{}
+func NewPowerRule(h *host.Host) *PowerRule {
+	return &PowerRule{host: h}
 }
 
 func (r *PowerRule) Name() string {
@@ -24,14 +26,14 @@ func (r *PowerRule) Description() string {
 	return "Validates that the system is running on IBM Power11 (ppc64le)"
 }
 
-func (r *PowerRule) Verify() error {
+func (r *PowerRule) Verify(ctx context.Context) error {
 	logger.Infoln("Validating IBM Power version...", logger.VerbosityLevelDebug)
 
-	if runtime.GOARCH != "ppc64le" {
-		return fmt.Errorf("unsupported architecture: %s. IBM Power architecture (ppc64le) is required", runtime.GOARCH)
+	if r.host.Arch != "ppc64le" {
+		return fmt.Errorf("unsupported architecture: %s. IBM Power architecture (ppc64le) is required", r.host.Arch)
 	}
 
-	data, err := os.ReadFile("/proc/cpuinfo")
+	data, err := r.host.FS.ReadFile("/proc/cpuinfo")
 	if err == nil && strings.Contains(strings.ToLower(string(data)), "power11") {
 		return nil
 	}
@@ -39,6 +41,27 @@ func (r *PowerRule) Verify() error {
 	return fmt.Errorf("unsupported IBM Power version: Power11 is required")
 }
 
+// Generation returns the processor generation of the host as reported by /proc/cpuinfo, Eg:- POWER11.
+func Generation(hostFS host.FS) (string, error) {
+	data, err := hostFS.ReadFile("/proc/cpuinfo")
+	if err != nil {
+		return "", fmt.Errorf("failed to read /proc/cpuinfo: %w", err)
+	}
+
+	for line := range strings.SplitSeq(string(data), "\n") {
+		// Eg:- cpu		: POWER11 (architected), altivec supported
+		key, value, ok := strings.Cut(line, ":")
+		if !ok || strings.TrimSpace(key) != "cpu" {
+			continue
+		}
+		if fields := strings.Fields(value); len(fields) > 0 {
+			return fields[0], nil
+		}
+	}
+
+	return "", fmt.Errorf("processor generation not found in /proc/cpuinfo")
+}
+
 func (r *PowerRule) Message() string {
 	return "System is running on IBM Power11 (ppc64le)"
 }
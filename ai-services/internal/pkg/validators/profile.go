@@ -0,0 +1,173 @@
+package validators
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+)
+
+// Built-in validation profiles.
+const (
+	// ProfileDefault keeps the level declared by each rule.
+	ProfileDefault = "default"
+	// ProfileStrict fails on any of the checks, Eg:- NUMA misalignment blocks the deployment.
+	ProfileStrict = "strict"
+	// ProfileLab only warns about the checks which lab systems are not expected to meet.
+	ProfileLab = "lab"
+)
+
+const (
+	levelError   = "error"
+	levelWarning = "warning"
+)
+
+// Profile overrides the levels of the validation rules.
+//
+// Profiles other than the built-in ones are loaded from a yaml file. Eg:-
+//
+//	name: site-a
+//	levels:
+//	  numa: error
+//	  rhn: warning
+type Profile struct {
+	Name string `yaml:"name" json:"name"`
+	// Level overrides the level of all the rules which are not listed in Levels
+	Level string `yaml:"level,omitempty" json:"level,omitempty"`
+	// Levels overrides the level of the rules by their name: error or warning
+	Levels map[string]string `yaml:"levels,omitempty" json:"levels,omitempty"`
+}
+
+var builtinProfiles = map[string]*Profile{
+	ProfileDefault: {Name: ProfileDefault},
+	ProfileStrict:  {Name: ProfileStrict, Level: levelError},
+	ProfileLab: {Name: ProfileLab, Levels: map[string]string{
+		"rhn":   levelWarning,
+		"rhel":  levelWarning,
+		"power": levelWarning,
+	}},
+}
+
+// DefaultProfile returns the profile keeping the level declared by each rule.
+func DefaultProfile() *Profile {
+	return builtinProfiles[ProfileDefault]
+}
+
+// ProfileNames returns the names of the built-in profiles.
+func ProfileNames() []string {
+	return []string{ProfileDefault, ProfileStrict, ProfileLab}
+}
+
+// LoadProfile returns the built-in profile with the given name, else loads the profile from the given yaml file.
+func LoadProfile(nameOrPath string) (*Profile, error) {
+	if nameOrPath == "" {
+		return DefaultProfile(), nil
+	}
+
+	if profile, ok := builtinProfiles[nameOrPath]; ok {
+		return profile, nil
+	}
+
+	ext := filepath.Ext(nameOrPath)
+	if ext != ".yaml" && ext != ".yml" {
+		return nil, fmt.Errorf("unknown validation profile '%s': must be one of %s or a yaml file", nameOrPath, strings.Join(ProfileNames(), ", "))
+	}
+
+	data, err := os.ReadFile(nameOrPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read validation profile: %w", err)
+	}
+
+	var profile Profile
+	if err := yaml.Unmarshal(data, &profile); err != nil {
+		return nil, fmt.Errorf("failed to parse validation profile %s: %w", nameOrPath, err)
+	}
+
+	if profile.Name == "" {
+		profile.Name = strings.TrimSuffix(filepath.Base(nameOrPath), ext)
+	}
+
+	if err := profile.validate(); err != nil {
+		return nil, fmt.Errorf("invalid validation profile %s: %w", nameOrPath, err)
+	}
+
+	return &profile, nil
+}
+
+func (p *Profile) validate() error {
+	if p.Level != "" && !isLevel(p.Level) {
+		return fmt.Errorf("invalid level '%s': must be %s or %s", p.Level, levelError, levelWarning)
+	}
+
+	names := make([]string, 0, len(p.Levels))
+	for name := range p.Levels {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	known := knownRuleNames()
+	for _, name := range names {
+		if !slices.Contains(known, name) {
+			return fmt.Errorf("unknown rule '%s': must be one of %s", name, strings.Join(known, ", "))
+		}
+		if !isLevel(p.Levels[name]) {
+			return fmt.Errorf("invalid level '%s' for rule '%s': must be %s or %s", p.Levels[name], name, levelError, levelWarning)
+		}
+	}
+
+	return nil
+}
+
+// knownRuleNames returns the names of the rules a profile may override the level of: the registered rules and the
+// rules verifying the host requirements of an application.
+func knownRuleNames() []string {
+	names := slices.Clone(RequirementRuleNames)
+	for _, rule := range DefaultRegistry.Rules() {
+		if !slices.Contains(names, rule.Name()) {
+			names = append(names, rule.Name())
+		}
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// LevelOf returns the level of the rule within the profile.
+func (p *Profile) LevelOf(rule Rule) constants.ValidationLevel {
+	level, ok := p.Levels[rule.Name()]
+	if !ok {
+		level = p.Level
+	}
+
+	switch level {
+	case levelError:
+		return constants.ValidationLevelError
+	case levelWarning:
+		return constants.ValidationLevelWarning
+	default:
+		return rule.Level()
+	}
+}
+
+// WithWarning returns a copy of the profile with the given rules lowered to the warning level.
+func (p *Profile) WithWarning(rules ...string) *Profile {
+	profile := &Profile{Name: p.Name, Level: p.Level, Levels: maps.Clone(p.Levels)}
+	if profile.Levels == nil {
+		profile.Levels = map[string]string{}
+	}
+	for _, rule := range rules {
+		profile.Levels[rule] = levelWarning
+	}
+
+	return profile
+}
+
+func isLevel(level string) bool {
+	return level == levelError || level == levelWarning
+}
@@ -0,0 +1,54 @@
+package validators
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeProfile writes the profile into a temporary site.yaml file and returns its path.
+func writeProfile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "site.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestLoadProfile(t *testing.T) {
+	// both the registered rules and the host requirement rules of the templates can be overridden
+	profile, err := LoadProfile(writeProfile(t, "level: warning\nlevels:\n  numa: error\n  model-disk-space: warning\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if profile.Name != "site" {
+		t.Errorf("expected the profile to be named after the file, got '%s'", profile.Name)
+	}
+	if profile.Level != levelWarning {
+		t.Errorf("expected the default level %s, got '%s'", levelWarning, profile.Level)
+	}
+	if want := map[string]string{"numa": levelError, "model-disk-space": levelWarning}; !maps.Equal(profile.Levels, want) {
+		t.Errorf("expected the levels %v, got %v", want, profile.Levels)
+	}
+}
+
+func TestLoadProfileRejectsInvalidLevels(t *testing.T) {
+	// the profiles are keyed by the error they are rejected with
+	profiles := map[string]string{
+		"unknown rule 'nuam'":                   "levels:\n  numa: error\n  nuam: warning\n",
+		"invalid level 'fatal' for rule 'numa'": "levels:\n  numa: fatal\n",
+		"invalid level 'info'":                  "level: info\n",
+	}
+
+	for wantErr, content := range profiles {
+		if _, err := LoadProfile(writeProfile(t, content)); err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Errorf("expected the profile to be rejected with '%s', got: %v", wantErr, err)
+		}
+	}
+}
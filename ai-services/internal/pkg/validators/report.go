@@ -0,0 +1,192 @@
+package validators
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+)
+
+const (
+	reportDirPerm  = 0o755
+	reportFilePerm = 0o644
+
+	junitSuiteName = "bootstrap-validate"
+)
+
+// Status represents the outcome of a validation rule.
+type Status string
+
+const (
+	StatusPass    Status = "pass"
+	StatusWarn    Status = "warn"
+	StatusFail    Status = "fail"
+	StatusSkipped Status = "skipped"
+)
+
+// Result holds the outcome of a single validation rule.
+type Result struct {
+	Name    string `json:"name"`
+	Level   string `json:"level"`
+	Status  Status `json:"status"`
+	Message string `json:"message"`
+	Hint    string `json:"hint,omitempty"`
+	// DurationSeconds is the time taken to verify the rule
+	DurationSeconds float64 `json:"durationSeconds"`
+}
+
+// Report holds the results of all the validation rules of a validation run.
+type Report struct {
+	Hostname string `json:"hostname"`
+	// Profile is the name of the validation profile used to run the rules
+	Profile         string    `json:"profile"`
+	Timestamp       time.Time `json:"timestamp"`
+	DurationSeconds float64   `json:"durationSeconds"`
+	Results         []Result  `json:"results"`
+}
+
+// NewReport creates a new report for a validation run using the given profile starting now.
+func NewReport(profile string) *Report {
+	hostname, _ := os.Hostname()
+
+	return &Report{Hostname: hostname, Profile: profile, Timestamp: time.Now(), Results: []Result{}}
+}
+
+// Add appends the result of a rule to the report.
+func (r *Report) Add(result Result) {
+	r.Results = append(r.Results, result)
+	r.DurationSeconds = time.Since(r.Timestamp).Seconds()
+}
+
+// Update replaces the result of the rule with the same name.
+func (r *Report) Update(result Result) {
+	for i := range r.Results {
+		if r.Results[i].Name == result.Name {
+			r.Results[i] = result
+
+			return
+		}
+	}
+}
+
+// Count returns the number of results with the given status.
+func (r *Report) Count(status Status) int {
+	count := 0
+	for _, result := range r.Results {
+		if result.Status == status {
+			count++
+		}
+	}
+
+	return count
+}
+
+// LevelName returns the name of the validation level used in the report.
+func LevelName(level constants.ValidationLevel) string {
+	if level == constants.ValidationLevelWarning {
+		return "warning"
+	}
+
+	return "error"
+}
+
+// WriteJSON writes the report as json to the given path, creating the parent directory if required.
+func (r *Report) WriteJSON(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal validation report: %w", err)
+	}
+
+	return writeReport(path, data)
+}
+
+// junitTestSuites is the root element of a JUnit XML report.
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	Timestamp string          `xml:"timestamp,attr"`
+	Hostname  string          `xml:"hostname,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the report in JUnit XML format to the given path, creating the parent directory if required.
+// Failed rules are reported as failures, skipped rules as skipped and warnings as passed test cases with the warning in system-out.
+func (r *Report) WriteJUnit(path string) error {
+	suite := junitTestSuite{
+		Name:      junitSuiteName,
+		Tests:     len(r.Results),
+		Failures:  r.Count(StatusFail),
+		Skipped:   r.Count(StatusSkipped),
+		Time:      formatSeconds(r.DurationSeconds),
+		Timestamp: r.Timestamp.Format(time.RFC3339),
+		Hostname:  r.Hostname,
+	}
+
+	for _, result := range r.Results {
+		tc := junitTestCase{Name: result.Name, ClassName: junitSuiteName, Time: formatSeconds(result.DurationSeconds)}
+		switch result.Status {
+		case StatusFail:
+			tc.Failure = &junitMessage{Message: result.Message, Text: result.Hint}
+		case StatusSkipped:
+			message := result.Message
+			if message == "" {
+				message = "skipped via --skip-validation"
+			}
+			tc.Skipped = &junitMessage{Message: message}
+		case StatusWarn:
+			tc.SystemOut = fmt.Sprintf("WARNING: %s\nHINT: %s", result.Message, result.Hint)
+		case StatusPass:
+			tc.SystemOut = result.Message
+		}
+		suite.TestCases = append(suite.TestCases, tc)
+	}
+
+	data, err := xml.MarshalIndent(junitTestSuites{Suites: []junitTestSuite{suite}}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal junit report: %w", err)
+	}
+
+	return writeReport(path, append([]byte(xml.Header), data...))
+}
+
+func writeReport(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), reportDirPerm); err != nil {
+		return fmt.Errorf("failed to create report directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, reportFilePerm); err != nil {
+		return fmt.Errorf("failed to write report %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func formatSeconds(seconds float64) string {
+	return fmt.Sprintf("%.3f", seconds)
+}
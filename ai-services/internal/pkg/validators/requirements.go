@@ -0,0 +1,56 @@
+package validators
+
+import (
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/disk"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/kernelmodules"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/memory"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/podmanversion"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/ports"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// Names of the rules verifying the host requirements of an application.
+const (
+	CheckModelDiskSpace = "model-disk-space"
+	CheckAppDiskSpace   = "app-disk-space"
+)
+
+// RequirementRuleNames lists the names of the rules verifying the host requirements, available for skipping during create.
+var RequirementRuleNames = []string{"memory", CheckModelDiskSpace, CheckAppDiskSpace, "ports", "kernel-modules", "podman-version"}
+
+// HostRequirements holds the host requirements of an application. Sizes are in bytes.
+type HostRequirements struct {
+	Memory         uint64
+	ModelDiskSpace uint64
+	AppDiskSpace   uint64
+	Ports          []int
+	KernelModules  []string
+	PodmanVersion  string
+}
+
+// Rules returns the validation rules verifying the requirements on the given host. Requirements which are not set are not verified.
+func (h HostRequirements) Rules(hst *host.Host) []Rule {
+	var rules []Rule
+	if h.PodmanVersion != "" {
+		rules = append(rules, podmanversion.NewPodmanVersionRule(hst, h.PodmanVersion))
+	}
+	if len(h.KernelModules) > 0 {
+		rules = append(rules, kernelmodules.NewKernelModulesRule(hst, h.KernelModules))
+	}
+	if h.Memory > 0 {
+		rules = append(rules, memory.NewMemoryRule(hst, h.Memory))
+	}
+	if h.ModelDiskSpace > 0 {
+		rules = append(rules, disk.NewDiskRule(hst, CheckModelDiskSpace, vars.ModelDirectory, h.ModelDiskSpace))
+	}
+	if h.AppDiskSpace > 0 {
+		rules = append(rules, disk.NewDiskRule(hst, CheckAppDiskSpace, constants.ApplicationsPath, h.AppDiskSpace))
+	}
+	if len(h.Ports) > 0 {
+		rules = append(rules, ports.NewPortsRule(h.Ports))
+	}
+
+	return rules
+}
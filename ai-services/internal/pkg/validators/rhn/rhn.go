@@ -1,18 +1,25 @@
 package rhn
 
 import (
+	"context"
 	"fmt"
-	"os/exec"
 	"strings"
+	"time"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 )
 
-type RHNRule struct{}
+// timeout allows dnf to refresh the repository metadata over a slow network.
+const timeout = 2 * time.Minute
 
-func NewRHNRule() *RHNRule {
-	return &RHNRule{}
+type RHNRule struct {
+	host *host.Host
+}
+
+func NewRHNRule(h *host.Host) *RHNRule {
+	return &RHNRule{host: h}
 }
 
 func (r *RHNRule) Name() string {
@@ -23,10 +30,9 @@ func (r *RHNRule) Description() string {
 	return "Validates that the system is registered with Red Hat Network (RHN)."
 }
 
-func (r *RHNRule) Verify() error {
+func (r *RHNRule) Verify(ctx context.Context) error {
 	logger.Infoln("Validating RHN registration...", logger.VerbosityLevelDebug)
-	cmd := exec.Command("dnf", "repolist")
-	output, err := cmd.CombinedOutput()
+	output, err := r.host.Runner.Run(ctx, "dnf", "repolist")
 
 	// Checking the output content first, as dnf may return non-zero exit code
 	// even when the system is registered
@@ -42,6 +48,10 @@ func (r *RHNRule) Verify() error {
 	return nil
 }
 
+func (r *RHNRule) Timeout() time.Duration {
+	return timeout
+}
+
 func (r *RHNRule) Message() string {
 	return "System is registered with RHN"
 }
@@ -1,17 +1,20 @@
 package root
 
 import (
+	"context"
 	"fmt"
-	"os"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 )
 
-type RootRule struct{}
+type RootRule struct {
+	host *host.Host
+}
 
-func NewRootRule() *RootRule {
-	return &RootRule{}
+func NewRootRule(h *host.Host) *RootRule {
+	return &RootRule{host: h}
 }
 
 func (r *RootRule) Name() string {
@@ -22,8 +25,8 @@ func (r *RootRule) Description() string {
 	return "Validates that the current user has root privileges."
 }
 
-func (r *RootRule) Verify() error {
-	euid := os.Geteuid()
+func (r *RootRule) Verify(ctx context.Context) error {
+	euid := r.host.EUID
 
 	logger.Infoln("Checking root privileges", logger.VerbosityLevelDebug)
 
@@ -0,0 +1,95 @@
+package selinux
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+const (
+	enforcePath = "/sys/fs/selinux/enforce"
+	// containerUseDevicesPath holds the current and the pending value of the boolean, Eg:- "1 1".
+	containerUseDevicesPath = "/sys/fs/selinux/booleans/container_use_devices"
+)
+
+// SELinuxRule validates that containers are allowed to use the host devices (Spyre cards) when SELinux is enforcing.
+type SELinuxRule struct {
+	host *host.Host
+}
+
+func NewSELinuxRule(h *host.Host) *SELinuxRule {
+	return &SELinuxRule{host: h}
+}
+
+func (r *SELinuxRule) Name() string {
+	return "selinux"
+}
+
+func (r *SELinuxRule) Description() string {
+	return "Validates that the container_use_devices SELinux boolean is enabled when SELinux is enforcing."
+}
+
+func (r *SELinuxRule) Verify(ctx context.Context) error {
+	logger.Infoln("Validating SELinux mode...", logger.VerbosityLevelDebug)
+	enforce, err := r.host.FS.ReadFile(enforcePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			logger.Infoln("SELinux is disabled", logger.VerbosityLevelDebug)
+
+			return nil
+		}
+
+		return fmt.Errorf("failed to read SELinux mode: %w", err)
+	}
+
+	if strings.TrimSpace(string(enforce)) != "1" {
+		logger.Infoln("SELinux is permissive", logger.VerbosityLevelDebug)
+
+		return nil
+	}
+
+	value, err := r.host.FS.ReadFile(containerUseDevicesPath)
+	if err != nil {
+		return fmt.Errorf("failed to read SELinux boolean container_use_devices: %w", err)
+	}
+
+	if fields := strings.Fields(string(value)); len(fields) == 0 || fields[0] != "1" {
+		return fmt.Errorf("SELinux is enforcing and container_use_devices is off")
+	}
+
+	return nil
+}
+
+func (r *SELinuxRule) Message() string {
+	return "SELinux allows containers to use the host devices"
+}
+
+func (r *SELinuxRule) Level() constants.ValidationLevel {
+	return constants.ValidationLevelError
+}
+
+func (r *SELinuxRule) Hint() string {
+	return "Enable the SELinux boolean using `setsebool -P container_use_devices=1` or run `ai-services bootstrap configure` or `ai-services bootstrap validate --fix`"
+}
+
+func (r *SELinuxRule) CanFix() bool {
+	_, err := r.host.Runner.LookPath("setsebool")
+
+	return err == nil
+}
+
+// Fix enables the container_use_devices SELinux boolean persistently.
+func (r *SELinuxRule) Fix(ctx context.Context) error {
+	out, err := r.host.Runner.Run(ctx, "setsebool", "-P", "container_use_devices=1")
+	if err != nil {
+		return fmt.Errorf("failed to enable container_use_devices: %v, output: %s", err, string(out))
+	}
+
+	return nil
+}
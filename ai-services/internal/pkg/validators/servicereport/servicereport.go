@@ -1,15 +1,35 @@
 package servicereport
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 )
 
-type ServiceReportRule struct{}
+type ServiceReportRule struct {
+	host *host.Host
+}
+
+// hostConfigDirs are the host directories mounted into the servicereport tool to persist the repairs.
+var hostConfigDirs = []string{"/etc/modules-load.d", "/etc/udev/rules.d"}
 
-func NewServiceReportRule() *ServiceReportRule {
-	return &ServiceReportRule{}
+const (
+	// ValidateCommand runs the ServiceReport tool validating the Spyre configuration of the host.
+	ValidateCommand = "servicereport -v -p spyre"
+
+	hostConfigDirPerm = 0o755
+	// timeout allows for pulling the tool image on the first run.
+	timeout = 5 * time.Minute
+)
+
+func NewServiceReportRule(h *host.Host) *ServiceReportRule {
+	return &ServiceReportRule{host: h}
 }
 
 func (r *ServiceReportRule) Name() string {
@@ -20,9 +40,9 @@ func (r *ServiceReportRule) Description() string {
 	return "Validates if the ServiceReport tool has been run on the LPAR."
 }
 
-func (r *ServiceReportRule) Verify() error {
+func (r *ServiceReportRule) Verify(ctx context.Context) error {
 	logger.Infoln("Validating if ServiceReport tool has run on LPAR", logger.VerbosityLevelDebug)
-	if err := helpers.RunServiceReportContainer("servicereport -v -p spyre", "validate"); err != nil {
+	if err := helpers.RunServiceReportContainer(ctx, r.host.Runner, ValidateCommand, "validate"); err != nil {
 		return err
 	}
 
@@ -38,5 +58,31 @@ func (r *ServiceReportRule) Level() constants.ValidationLevel {
 }
 
 func (r *ServiceReportRule) Hint() string {
-	return "ServiceReport tool needs to be run on LPAR, please use `ai-services bootstrap configure`"
+	return "ServiceReport tool needs to be run on LPAR, please use `ai-services bootstrap configure` or `ai-services bootstrap validate --fix`"
+}
+
+// DependsOn returns the rules required to run the ServiceReport tool: the Spyre cards and podman.
+func (r *ServiceReportRule) DependsOn() []string {
+	return []string{"spyre", "podman"}
+}
+
+func (r *ServiceReportRule) Timeout() time.Duration {
+	return timeout
+}
+
+func (r *ServiceReportRule) CanFix() bool {
+	_, err := r.host.Runner.LookPath("podman")
+
+	return err == nil
+}
+
+// Fix repairs the Spyre configuration by running the ServiceReport tool in repair mode.
+func (r *ServiceReportRule) Fix(ctx context.Context) error {
+	for _, dir := range hostConfigDirs {
+		if err := os.MkdirAll(dir, hostConfigDirPerm); err != nil {
+			return fmt.Errorf("failed to create host volume mount %s for servicereport tool: %w", dir, err)
+		}
+	}
+
+	return helpers.RunServiceReportContainer(ctx, r.host.Runner, "servicereport -r -p spyre", "configure")
 }
@@ -0,0 +1,102 @@
+package smt
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+// SMTRule validates that the SMT level of the LPAR matches the level required by the application template.
+// Not part of the default registry as the target level is specific to the application template.
+type SMTRule struct {
+	host   *host.Host
+	target int
+}
+
+func NewSMTRule(h *host.Host, target int) *SMTRule {
+	return &SMTRule{host: h, target: target}
+}
+
+func (r *SMTRule) Name() string {
+	return "smt"
+}
+
+func (r *SMTRule) Description() string {
+	return "Validates that the SMT level of the LPAR matches the level required by the application."
+}
+
+func (r *SMTRule) Verify(ctx context.Context) error {
+	logger.Infoln("Validating SMT level...", logger.VerbosityLevelDebug)
+	current, err := CurrentLevel(ctx, r.host.Runner)
+	if err != nil {
+		return err
+	}
+
+	if current != r.target {
+		return fmt.Errorf("SMT level is set to %d, required: %d", current, r.target)
+	}
+
+	return nil
+}
+
+func (r *SMTRule) Message() string {
+	return fmt.Sprintf("SMT level is set to %d", r.target)
+}
+
+func (r *SMTRule) Level() constants.ValidationLevel {
+	return constants.ValidationLevelError
+}
+
+func (r *SMTRule) Hint() string {
+	return fmt.Sprintf("Set the SMT level using `ppc64_cpu --smt=%d`", r.target)
+}
+
+func (r *SMTRule) CanFix() bool {
+	_, err := r.host.Runner.LookPath("ppc64_cpu")
+
+	return err == nil
+}
+
+// Fix sets the SMT level to the target level.
+func (r *SMTRule) Fix(ctx context.Context) error {
+	out, err := r.host.Runner.Run(ctx, "ppc64_cpu", "--smt="+strconv.Itoa(r.target))
+	if err != nil {
+		return fmt.Errorf("failed to set SMT level: %v, output: %s", err, string(out))
+	}
+
+	return nil
+}
+
+// CurrentLevel returns the current SMT level of the LPAR.
+func CurrentLevel(ctx context.Context, runner host.CommandRunner) (int, error) {
+	out, err := runner.Run(ctx, "ppc64_cpu", "--smt")
+	if err != nil {
+		return 0, fmt.Errorf("failed to check current SMT level: %v, output: %s", err, string(out))
+	}
+
+	return parseLevel(string(out))
+}
+
+// parseLevel parses the output of `ppc64_cpu --smt`. Eg:- SMT=2, or "SMT is off" for level 1.
+func parseLevel(output string) (int, error) {
+	out := strings.TrimSpace(output)
+	if out == "SMT is off" {
+		return 1, nil
+	}
+
+	if !strings.HasPrefix(out, "SMT=") {
+		return 0, fmt.Errorf("unexpected output: %s", out)
+	}
+
+	level, err := strconv.Atoi(strings.TrimPrefix(out, "SMT="))
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse SMT level: %w", err)
+	}
+
+	return level, nil
+}
@@ -1,19 +1,21 @@
 package spyre
 
 import (
+	"context"
 	"fmt"
-	"os/exec"
-	"strconv"
-	"strings"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 )
 
-type SpyreRule struct{}
+type SpyreRule struct {
+	host *host.Host
+}
 
-func NewSpyreRule() *SpyreRule {
-	return &SpyreRule{}
+func NewSpyreRule(h *host.Host) *SpyreRule {
+	return &SpyreRule{host: h}
 }
 
 func (r *SpyreRule) Name() string {
@@ -24,18 +26,13 @@ func (r *SpyreRule) Description() string {
 	return "Validates that the IBM Spyre Accelerator is attached to the LPAR."
 }
 
-func (r *SpyreRule) Verify() error {
+func (r *SpyreRule) Verify(ctx context.Context) error {
 	logger.Infoln("Validating Spyre attachment...", logger.VerbosityLevelDebug)
-	cmd := `lspci -k -d 1014:06a7 | wc -l`
-	out, err := exec.Command("bash", "-c", cmd).Output()
+	cards, err := helpers.ListSpyreCards(ctx, r.host.Runner)
 	if err != nil {
 		return fmt.Errorf("❌ failed to execute lspci command %w", err)
 	}
-	cardsCount, err := strconv.Atoi(strings.TrimSpace(string(out)))
-	if err != nil {
-		return fmt.Errorf("failed to parse spyre cards count: %w", err)
-	}
-	if cardsCount == 0 {
+	if len(cards) == 0 {
 		return fmt.Errorf("IBM Spyre Accelerator is not attached to the LPAR")
 	}
 
@@ -1,33 +1,90 @@
 package validators
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/disk"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/external"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/hugepages"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/memlock"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators/numa"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators/platform"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/podmanversion"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators/power"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators/rhn"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators/root"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/selinux"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators/servicereport"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators/spyre"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/vfio"
 )
 
 // Initialize the default registry with built-in rules.
 func init() {
-	// adding root rule on top to verify this check first
-	DefaultRegistry.Register(root.NewRootRule())
-	DefaultRegistry.Register(numa.NewNumaRule())
-	DefaultRegistry.Register(platform.NewPlatformRule())
-	DefaultRegistry.Register(power.NewPowerRule())
-	DefaultRegistry.Register(rhn.NewRHNRule())
-	DefaultRegistry.Register(spyre.NewSpyreRule())
-	DefaultRegistry.Register(servicereport.NewServiceReportRule())
+	for _, rule := range BuiltinRules(host.Local()) {
+		DefaultRegistry.Register(rule)
+	}
+
+	registerExternalRules(external.RulesDir)
+}
+
+// BuiltinRules returns the built-in rules verifying the given host, in the order they are run.
+func BuiltinRules(h *host.Host) []Rule {
+	return []Rule{
+		// adding root rule on top to verify this check first
+		root.NewRootRule(h),
+		numa.NewNumaRule(h),
+		platform.NewPlatformRule(h),
+		power.NewPowerRule(h),
+		rhn.NewRHNRule(h),
+		spyre.NewSpyreRule(h),
+		vfio.NewVfioRule(h),
+		podmanversion.NewPodmanRule(h),
+		servicereport.NewServiceReportRule(h),
+		selinux.NewSELinuxRule(h),
+		memlock.NewMemlockRule(h),
+		hugepages.NewHugePagesRule(h),
+		disk.NewDataDiskRule(h),
+	}
+}
+
+// externalRulesErr holds the errors encountered while loading the external rules.
+// Reported via ExternalRulesError as the logger is not initialized yet during init.
+var externalRulesErr error
+
+// registerExternalRules registers the site specific rules declared under the given directory.
+// Invalid rules and rules clashing with the already registered ones are skipped.
+func registerExternalRules(dir string) {
+	rules, err := external.Load(dir)
+	errs := []error{err}
+
+	for _, rule := range rules {
+		if DefaultRegistry.Get(rule.Name()) != nil {
+			errs = append(errs, fmt.Errorf("rule '%s' from %s: a rule with the same name is already registered", rule.Name(), dir))
+
+			continue
+		}
+		DefaultRegistry.Register(rule)
+	}
+
+	externalRulesErr = errors.Join(errs...)
+}
+
+// ExternalRulesError returns the errors encountered while loading the external rules, nil if none.
+func ExternalRulesError() error {
+	return externalRulesErr
 }
 
 // Rule defines the interface for validation rules.
 type Rule interface {
-	Verify() error
+	// Verify verifies the host, the commands run are killed once the context is done
+	Verify(ctx context.Context) error
 	Message() string
 	Name() string
 	Level() constants.ValidationLevel
@@ -35,6 +92,47 @@ type Rule interface {
 	Description() string
 }
 
+// DefaultTimeout is the time a rule is allowed to take to verify unless it declares its own timeout.
+const DefaultTimeout = 30 * time.Second
+
+// Dependent is optionally implemented by the rules which require other rules to pass first, Eg:- servicereport
+// requires the Spyre cards to be attached. A rule may only depend on the rules registered before it.
+type Dependent interface {
+	DependsOn() []string
+}
+
+// TimeLimited is optionally implemented by the rules which take longer than DefaultTimeout to verify.
+type TimeLimited interface {
+	Timeout() time.Duration
+}
+
+// Dependencies returns the names of the rules the rule depends on.
+func Dependencies(rule Rule) []string {
+	if d, ok := rule.(Dependent); ok {
+		return d.DependsOn()
+	}
+
+	return nil
+}
+
+// Timeout returns the time the rule is allowed to take to verify.
+func Timeout(rule Rule) time.Duration {
+	if t, ok := rule.(TimeLimited); ok {
+		return t.Timeout()
+	}
+
+	return DefaultTimeout
+}
+
+// Fixer is optionally implemented by the rules which have a well-known safe fix.
+// Rules without a safe fix should not implement it and are only reported.
+type Fixer interface {
+	// CanFix reports whether the fix can be applied on the current host.
+	CanFix() bool
+	// Fix remediates the failed rule. The rule is expected to be verified again after the fix.
+	Fix(ctx context.Context) error
+}
+
 // DefaultRegistry is the default registry instance that holds all registered checks.
 var DefaultRegistry = NewValidationRegistry()
 
@@ -58,6 +156,20 @@ func (r *ValidationRegistry) Register(rule Rule) {
 	r.rules = append(r.rules, rule)
 }
 
+// Get returns the registered check with the given name, nil if not found.
+func (r *ValidationRegistry) Get(name string) Rule {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, rule := range r.rules {
+		if rule.Name() == name {
+			return rule
+		}
+	}
+
+	return nil
+}
+
 // Rules returns the list of registered checks.
 func (r *ValidationRegistry) Rules() []Rule {
 	r.mu.RLock()
@@ -0,0 +1,65 @@
+package validators
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/host/hosttest"
+)
+
+const fixturesDir = "../host/testdata"
+
+func TestBuiltinRulesAgainstFixtures(t *testing.T) {
+	tests := []struct {
+		fixture string
+		// failing are the rules expected to fail on the fixture, all the others are expected to pass
+		failing []string
+	}{
+		{
+			fixture: "power10-rhel94",
+			failing: []string{"numa", "rhel", "power", "rhn", "spyre", "vfio", "servicereport", "selinux", "memlock", "hugepages", "disk"},
+		},
+		{
+			fixture: "power10-rhel96",
+			failing: []string{"numa", "power", "spyre", "servicereport"},
+		},
+		{
+			fixture: "power11-rhel94",
+			failing: []string{"rhel"},
+		},
+		{
+			fixture: "power11-rhel96",
+		},
+	}
+
+	fixtures, err := hosttest.ListFixtures(fixturesDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fixtures) != len(tests) {
+		t.Fatalf("expected a test case for each of the fixtures %v", fixtures)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			fixture, err := hosttest.LoadFixture(fixturesDir, tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+			h, err := fixture.Host()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, rule := range BuiltinRules(h) {
+				err := rule.Verify(t.Context())
+				switch expectFail := slices.Contains(tt.failing, rule.Name()); {
+				case expectFail && err == nil:
+					t.Errorf("rule %s: expected to fail, passed", rule.Name())
+				case !expectFail && err != nil:
+					t.Errorf("rule %s: expected to pass, failed: %v", rule.Name(), err)
+				}
+			}
+		})
+	}
+}
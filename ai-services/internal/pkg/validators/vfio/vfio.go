@@ -0,0 +1,65 @@
+package vfio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/host"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+// vfioPCIModulePath is present once the vfio_pci kernel module is loaded.
+const vfioPCIModulePath = "/sys/module/vfio_pci"
+
+type VfioRule struct {
+	host *host.Host
+}
+
+func NewVfioRule(h *host.Host) *VfioRule {
+	return &VfioRule{host: h}
+}
+
+func (r *VfioRule) Name() string {
+	return "vfio"
+}
+
+func (r *VfioRule) Description() string {
+	return "Validates that the vfio_pci kernel module required by the Spyre cards is loaded."
+}
+
+func (r *VfioRule) Verify(ctx context.Context) error {
+	logger.Infoln("Validating vfio kernel modules...", logger.VerbosityLevelDebug)
+	if _, err := r.host.FS.Stat(vfioPCIModulePath); err != nil {
+		return fmt.Errorf("vfio_pci kernel module is not loaded")
+	}
+
+	return nil
+}
+
+func (r *VfioRule) Message() string {
+	return "vfio_pci kernel module is loaded"
+}
+
+func (r *VfioRule) Level() constants.ValidationLevel {
+	return constants.ValidationLevelError
+}
+
+func (r *VfioRule) Hint() string {
+	return "Load the vfio kernel modules using `modprobe vfio_pci` or run `ai-services bootstrap validate --fix`"
+}
+
+func (r *VfioRule) CanFix() bool {
+	_, err := r.host.Runner.LookPath("modprobe")
+
+	return err == nil
+}
+
+func (r *VfioRule) Fix(ctx context.Context) error {
+	out, err := r.host.Runner.Run(ctx, "modprobe", "vfio_pci")
+	if err != nil {
+		return fmt.Errorf("failed to load vfio kernel modules: %v, output: %s", err, string(out))
+	}
+
+	return nil
+}
@@ -10,6 +10,10 @@ var (
 	SpyreCardAnnotationRegex = regexp.MustCompile(`^ai-services\.io\/([A-Za-z0-9][-A-Za-z0-9_.]*)--spyre-cards$`)
 	ToolImage                = "icr.io/ai-services/tools:0.5"
 	ModelDirectory           = "/var/lib/ai-services/models"
+	// Connection -> name of the Podman connection to use, set via the global --connection flag.
+	Connection string
+	// Timeout -> maximum duration for a command to complete, set via the global --timeout flag (0 means no timeout).
+	Timeout time.Duration
 )
 
 type Label string